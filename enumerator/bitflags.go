@@ -0,0 +1,262 @@
+package enumerator
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// minBitFlags is the minimum number of single-bit constants
+// required before an enum is detected as a set of bit flags.
+const minBitFlags = 3
+
+// isBitFlags determines if cs look like a set of bit flags. That is the case when
+// at least minBitFlags constants have a single bit set, each of them is declared with
+// a shift expression (see findShiftedConstants), and every other constant is either
+// zero or a combination of those bits that is declared after all of them.
+// Without the last conditions, values such as 0, 1, 2, 3, 4 or 1, 2, 4 that merely
+// happen to be powers of two would be detected as bit flags.
+func isBitFlags(cs []*enumConstant, kind constant.Kind, shifted map[*types.Const]bool) bool {
+	if kind != constant.Int {
+		return false
+	}
+
+	bits := singleBitConstants(cs)
+	if len(bits) < minBitFlags {
+		return false
+	}
+
+	for _, c := range bits {
+		if !shifted[c.Const] {
+			return false
+		}
+	}
+
+	mask := constant.MakeInt64(0)
+	for _, c := range bits {
+		mask = constant.BinaryOp(mask, token.OR, c.Val())
+	}
+
+	last := bits[len(bits)-1]
+	seenLast := false
+	for _, c := range uniqueConstants(cs) {
+		if constant.Sign(c.Val()) < 0 {
+			return false
+		}
+
+		if constant.Sign(constant.BinaryOp(c.Val(), token.AND_NOT, mask)) != 0 {
+			return false
+		}
+
+		if c == last {
+			seenLast = true
+		}
+
+		if !seenLast && constant.Sign(c.Val()) != 0 && !isSingleBit(c.Val()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findShiftedConstants finds the constants cs in files that are declared with a shift expression, such as
+// 1 << iota. Constants without a value in a const group repeat the expression of the previous one.
+func findShiftedConstants(files []*ast.File, info *types.Info, cs []*types.Const) map[*types.Const]bool {
+	want := make(map[*types.Const]bool, len(cs))
+	for _, c := range cs {
+		want[c] = true
+	}
+
+	ret := make(map[*types.Const]bool)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			var values []ast.Expr
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if len(vs.Values) > 0 {
+					values = vs.Values
+				}
+
+				for i, ident := range vs.Names {
+					c, ok := info.Defs[ident].(*types.Const)
+					if ok && want[c] && i < len(values) && containsShift(values[i]) {
+						ret[c] = true
+					}
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// containsShift returns true if expr contains a left shift.
+func containsShift(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if b, ok := n.(*ast.BinaryExpr); ok && b.Op == token.SHL {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isSingleBit returns true if v is a positive integer with exactly one bit set.
+func isSingleBit(v constant.Value) bool {
+	if v.Kind() != constant.Int || constant.Sign(v) <= 0 {
+		return false
+	}
+
+	return constant.Sign(constant.BinaryOp(v, token.AND, constant.BinaryOp(v, token.SUB, constant.MakeInt64(1)))) == 0
+}
+
+// singleBitConstants returns the constants in cs with exactly one bit set,
+// in the order they are declared. Aliases are skipped.
+func singleBitConstants(cs []*enumConstant) []*enumConstant {
+	var ret []*enumConstant
+	for _, c := range uniqueConstants(cs) {
+		if isSingleBit(c.Val()) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// hasZeroConstant returns true if one of cs has the value zero.
+func hasZeroConstant(cs []*enumConstant) bool {
+	for _, c := range cs {
+		if constant.Sign(c.Val()) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// generateFlagsStringMethod generates the String() method for a set of bit flags.
+func generateFlagsStringMethod(f *jen.File, receiver string, tn *types.TypeName, cs []*enumConstant) {
+	f.Commentf("String implements fmt.Stringer. If %s is not a single defined value, then the names of the flags that are set are joined with %q.", receiver, "|")
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("String").Params().String().Block(
+		jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+			for _, c := range uniqueConstants(cs) {
+				g.Case(jen.Id(c.Name())).Block(jen.Return(jen.Lit(c.text)))
+			}
+		}),
+		jen.Return(jen.String().Parens(jen.Id(receiver).Dot("Bytes").Call())),
+	)
+}
+
+// generateFlagsBytesMethod generates the Bytes() method for a set of bit flags.
+func generateFlagsBytesMethod(f *jen.File, receiver string, tn *types.TypeName, cs []*enumConstant, xVarName, yVarName string) {
+	f.Commentf("Bytes returns a byte-level representation of String(). Any bits of %s that do not belong to a defined flag are formatted based on their value.", receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Bytes").Params().Op("[]").Byte().BlockFunc(func(g *jen.Group) {
+		g.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+			for _, c := range uniqueConstants(cs) {
+				g.Case(jen.Id(c.Name())).Block(jen.Return(litBytes(c.text)))
+			}
+		})
+		g.Line()
+		g.Var().Id(xVarName).Op("[]").Byte()
+		g.Id(yVarName).Op(":=").Id(receiver)
+		for _, c := range singleBitConstants(cs) {
+			g.If(jen.Id(yVarName).Op("&").Id(c.Name()).Op("!=").Lit(0)).Block(
+				jen.Id(xVarName).Op("=").Append(jen.Id(xVarName), jen.Lit("|"+c.text).Op("...")),
+				jen.Id(yVarName).Op("&^=").Id(c.Name()),
+			)
+		}
+		g.If(jen.Id(yVarName).Op("!=").Lit(0).Op("||").Len(jen.Id(xVarName)).Op("==").Lit(0)).Block(
+			jen.Id(xVarName).Op("=").Append(jen.Id(xVarName), jen.Qual("fmt", "Sprintf").Call(jen.Lit("|"+tn.Name()+"(%d)"), jen.Id(yVarName)).Op("...")),
+		)
+		g.Return(jen.Id(xVarName).Index(jen.Lit(1), jen.Empty()))
+	})
+}
+
+// generateFlagsDefinedMethod generates the Defined() method for a set of bit flags.
+func generateFlagsDefinedMethod(f *jen.File, receiver string, tn *types.TypeName, cs []*enumConstant) {
+	mask := jen.Null()
+	for i, c := range singleBitConstants(cs) {
+		if i > 0 {
+			mask.Op("|")
+		}
+		mask.Id(c.Name())
+	}
+
+	defined := jen.Id(receiver).Op("&^").Parens(mask).Op("==").Lit(0)
+	if !hasZeroConstant(cs) {
+		defined = jen.Id(receiver).Op("!=").Lit(0).Op("&&").Add(defined)
+	}
+
+	f.Commentf("Defined returns true if %s holds a defined value or a combination of defined flags.", receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Defined").Params().Bool().Block(
+		jen.Return(defined),
+	)
+}
+
+// generateFlagsParseFunction generates the Parse<Type>() function for a set of bit flags.
+// Flag names are separated by "|", and the names of all constants, including aliases, are accepted.
+func generateFlagsParseFunction(f *jen.File, tn *types.TypeName, stringVarName, xVarName string, cs []*enumConstant, ignoreCase bool) {
+	sVarName := safeIndent("s", stringVarName, xVarName)
+	f.Commentf("%s returns the %s named %s. Multiple flags can be separated by %q.", parseFuncName(tn), tn.Name(), sVarName, "|")
+	f.Commentf("An error is returned if %s contains a name that is not defined.", sVarName)
+	f.Func().Id(parseFuncName(tn)).Params(jen.Id(sVarName).String()).Params(jen.Id(tn.Name()), jen.Error()).Block(
+		jen.Var().Id(xVarName).Id(tn.Name()),
+		generateFlagsParseLoop(jen.Id(sVarName), stringVarName, xVarName, cs, ignoreCase,
+			jen.Return(jen.Lit(0), newParseError(tn, jen.Id(stringVarName))),
+		),
+		jen.Return(jen.Id(xVarName), jen.Nil()),
+	)
+}
+
+// generateFlagsParseLoop generates a loop that splits input on "|" and combines the
+// flags with matching names into resultVarName. fail is executed for unknown names.
+func generateFlagsParseLoop(input jen.Code, stringVarName, resultVarName string, cs []*enumConstant, ignoreCase bool, fail jen.Code) jen.Code {
+	return jen.For(jen.List(jen.Id("_"), jen.Id(stringVarName)).Op(":=").Range().Qual("strings", "Split").Call(input, jen.Lit("|"))).Block(
+		jen.Switch(foldInput(jen.Id(stringVarName), ignoreCase)).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Case(parseLits(c, ignoreCase, "")...).Block(
+					jen.Id(resultVarName).Op("|=").Id(c.Name()),
+				)
+			}
+			g.Default().Block(fail)
+		}),
+	)
+}
+
+// generateFlagsMethods generates the Has(), Set(), Clear() and Toggle() methods for a set of bit flags.
+func generateFlagsMethods(f *jen.File, receiver string, tn *types.TypeName, flagsVarName string) {
+	params := func() *jen.Statement {
+		return jen.Params(jen.Id(flagsVarName).Id(tn.Name()))
+	}
+
+	f.Commentf("Has returns true if all the flags set in %s are also set in %s.", flagsVarName, receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Has").Add(params()).Bool().Block(
+		jen.Return(jen.Id(receiver).Op("&").Id(flagsVarName).Op("==").Id(flagsVarName)),
+	)
+
+	f.Line()
+	f.Commentf("Set returns %s with the flags set in %s set.", receiver, flagsVarName)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Set").Add(params()).Id(tn.Name()).Block(
+		jen.Return(jen.Id(receiver).Op("|").Id(flagsVarName)),
+	)
+
+	f.Line()
+	f.Commentf("Clear returns %s with the flags set in %s cleared.", receiver, flagsVarName)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Clear").Add(params()).Id(tn.Name()).Block(
+		jen.Return(jen.Id(receiver).Op("&^").Id(flagsVarName)),
+	)
+
+	f.Line()
+	f.Commentf("Toggle returns %s with the flags set in %s toggled.", receiver, flagsVarName)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Toggle").Add(params()).Id(tn.Name()).Block(
+		jen.Return(jen.Id(receiver).Op("^").Id(flagsVarName)),
+	)
+}
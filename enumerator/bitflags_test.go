@@ -0,0 +1,93 @@
+package enumerator
+
+import (
+	"testing"
+)
+
+func TestIsBitFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{
+			"shifted iota",
+			`const (
+				Read T = 1 << iota
+				Write
+				Exec
+			)`,
+			true,
+		},
+		{
+			"shifted literals and a combination",
+			`const (
+				Read  T = 1 << 0
+				Write T = 1 << 1
+				Exec  T = 1 << 2
+				All     = Read | Write | Exec
+			)`,
+			true,
+		},
+		{
+			"powers of two without shifts",
+			`const (
+				Small  T = 1
+				Medium T = 2
+				Large  T = 4
+			)`,
+			false,
+		},
+		{
+			"a single bit without a shift",
+			`const (
+				Read  T = 1 << 0
+				Write T = 1 << 1
+				Exec  T = 4
+			)`,
+			false,
+		},
+		{
+			"sequential",
+			`const (
+				A T = iota << 0
+				B
+				C
+				D
+				E
+			)`,
+			false,
+		},
+		{
+			"too few bits",
+			`const (
+				Read T = 1 << iota
+				Write
+			)`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadTestPackage(t, map[string]string{"t.go": "package t\n\ntype T int\n\n" + tt.src})
+			tn, err := findTypeDeclByName(pkg.TypesInfo, "T")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			vs, kind, err := findConstantsOfType(pkg.Fset, pkg.TypesInfo, tn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cs, err := newEnumConstants(pkg.Fset, vs, enumOptions{}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := isBitFlags(cs, kind, findShiftedConstants(pkg.Syntax, pkg.TypesInfo, vs)); got != tt.want {
+				t.Errorf("isBitFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
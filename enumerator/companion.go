@@ -0,0 +1,103 @@
+package enumerator
+
+import (
+	"go/constant"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// companionFuncName returns the name of the function generated in place of the method
+// named method of the imported type tn.
+func companionFuncName(tn *types.TypeName, method string) string {
+	return tn.Name() + method
+}
+
+// generateCompanionCode generates the functions that stand in for the methods of tn, a type
+// imported from the package opts.importPath. Methods cannot be declared on imported types, so
+// String(), Defined() and Next() become functions that take the value as their parameter.
+// opts.receiver is used as the name of that parameter. opts.methods must already be resolved.
+func generateCompanionCode(f *jen.File, tn *types.TypeName, cs []*enumConstant, kind constant.Kind, opts enumOptions) {
+	path, x := opts.importPath, opts.receiver
+	stringVarName := safeIndent("str", x)
+	xVarName := safeIndent("x", x, stringVarName)
+	gen := opts.methods
+
+	if gen[methodString] {
+		f.Line()
+		f.Commentf("%s returns the name of %s. If !%s(%s), then a generated string is returned based on %s's value.", companionFuncName(tn, "String"), x, companionFuncName(tn, "Defined"), x, x)
+		f.Func().Id(companionFuncName(tn, "String")).Params(jen.Id(x).Qual(path, tn.Name())).String().BlockFunc(func(g *jen.Group) {
+			if kind == constant.String {
+				g.Return(jen.String().Parens(jen.Id(x)))
+				return
+			}
+
+			g.Switch(jen.Id(x)).BlockFunc(func(g *jen.Group) {
+				for _, c := range uniqueConstants(cs) {
+					g.Case(jen.Qual(path, c.Name())).Block(jen.Return(jen.Lit(c.text)))
+				}
+			})
+			g.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit(undefinedFormat(tn, kind)), jen.Id(x)))
+		})
+	}
+
+	if gen[methodDefined] {
+		f.Line()
+		f.Commentf("%s returns true if %s holds a defined value.", companionFuncName(tn, "Defined"), x)
+		f.Func().Id(companionFuncName(tn, "Defined")).Params(jen.Id(x).Qual(path, tn.Name())).Bool().Block(
+			jen.Switch(jen.Id(x)).Block(
+				jen.CaseFunc(func(g *jen.Group) {
+					for _, c := range uniqueConstants(cs) {
+						g.Add(constantLit(c.Const, kind))
+					}
+				}).Block(jen.Return(jen.True())),
+				jen.Default().Block(jen.Return(jen.False())),
+			),
+		)
+	}
+
+	if gen[methodParse] {
+		f.Line()
+		generateParseErrors(f, tn, cs, false, opts.closestNames)
+
+		f.Line()
+		generateParseFunction(f, tn, path, kind, stringVarName, cs, opts.ignoreCase)
+	}
+
+	if gen[methodMustParse] {
+		f.Line()
+		generateMustParseFunction(f, tn, path, stringVarName, xVarName)
+	}
+
+	if gen[methodNext] {
+		f.Line()
+		f.Commentf("%s returns the next defined %s after %s. If %s is not defined, then %s returns the first defined value.", companionFuncName(tn, "Next"), tn.Name(), x, x, companionFuncName(tn, "Next"))
+		f.Commentf("Use %s() to loop through the values in the order they are declared.", valuesFuncName(tn))
+		unique := uniqueConstants(cs)
+		f.Func().Id(companionFuncName(tn, "Next")).Params(jen.Id(x).Qual(path, tn.Name())).Qual(path, tn.Name()).Block(
+			jen.Switch(jen.Id(x)).BlockFunc(func(g *jen.Group) {
+				for i, c := range unique {
+					g.Case(jen.Qual(path, c.Name())).Block(jen.Return(jen.Qual(path, unique[(i+1)%len(unique)].Name())))
+				}
+				g.Default().Block(jen.Return(jen.Qual(path, unique[0].Name())))
+			}),
+		)
+	}
+
+	if gen[methodValues] {
+		f.Line()
+		generateValuesFunctions(f, tn, path, cs)
+
+		if opts.iterators {
+			f.Line()
+			generateIteratorFunctions(f, tn, path, cs)
+		}
+	}
+
+	if gen[methodCheck] {
+		f.Line()
+		generateCompileCheckFunction(f, tn, path, xVarName, cs, kind)
+	}
+
+	f.Line()
+}
@@ -0,0 +1,172 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// directivePrefix is the prefix of comments that hold directives for a constant.
+const directivePrefix = "enum:"
+
+// constantDirective holds the options specified for a single constant
+// with a comment directive. For example:
+//
+//	StatusOK Status = iota // enum:"ok"
+//	StatusInProgress       // enum:name=in-progress alias=pending,started
+type constantDirective struct {
+	// name overrides the external representation of the constant.
+	name string
+	// aliases are additional names accepted when parsing the constant.
+	aliases []string
+}
+
+// findConstantDirectives finds the comment directives of the constants cs in files.
+// Both doc comments and line comments are searched.
+func findConstantDirectives(fset *token.FileSet, files []*ast.File, info *types.Info, cs []*types.Const) (map[*types.Const]constantDirective, error) {
+	want := make(map[*types.Const]bool, len(cs))
+	for _, c := range cs {
+		want[c] = true
+	}
+
+	ret := make(map[*types.Const]constantDirective)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+
+				doc := vs.Doc
+				if doc == nil && !gd.Lparen.IsValid() {
+					doc = gd.Doc
+				}
+
+				for _, ident := range vs.Names {
+					c, ok := info.Defs[ident].(*types.Const)
+					if !ok || !want[c] {
+						continue
+					}
+
+					d, found, err := parseDirectives(fset, doc, vs.Comment)
+					if err != nil {
+						return nil, err
+					}
+
+					if !found {
+						continue
+					}
+
+					if len(vs.Names) > 1 && d.name != "" {
+						return nil, fmt.Errorf("%s: name directive cannot be used on a declaration of multiple constants", fset.Position(vs.Pos()))
+					}
+
+					ret[c] = d
+				}
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// parseDirectives parses the directives in the comment groups cgs.
+// found reports whether any directive was present.
+func parseDirectives(fset *token.FileSet, cgs ...*ast.CommentGroup) (d constantDirective, found bool, err error) {
+	for _, cg := range cgs {
+		if cg == nil {
+			continue
+		}
+
+		for _, c := range cg.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if !strings.HasPrefix(text, directivePrefix) {
+				continue
+			}
+
+			err = parseDirective(strings.TrimPrefix(text, directivePrefix), &d)
+			if err != nil {
+				return d, false, fmt.Errorf("%s: invalid directive %q: %w", fset.Position(c.Pos()), text, err)
+			}
+			found = true
+		}
+	}
+
+	return d, found, nil
+}
+
+// parseDirective parses the text of a single directive (without the prefix) into d.
+// The text is either a quoted name, or a space separated list of key=value pairs.
+func parseDirective(text string, d *constantDirective) error {
+	if strings.HasPrefix(text, `"`) {
+		quoted, err := strconv.QuotedPrefix(text)
+		if err != nil {
+			return err
+		}
+
+		if rest := strings.TrimSpace(text[len(quoted):]); rest != "" {
+			return fmt.Errorf("unexpected text after name: %q", rest)
+		}
+
+		d.name, err = strconv.Unquote(quoted)
+		if err != nil {
+			return err
+		}
+
+		return validateDirectiveName(d.name)
+	}
+
+	for _, field := range strings.Fields(text) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return fmt.Errorf("expected key=value, found %q", field)
+		}
+
+		switch key {
+		case "name":
+			d.name = value
+			if err := validateDirectiveName(d.name); err != nil {
+				return err
+			}
+		case "alias":
+			for _, alias := range strings.Split(value, ",") {
+				if err := validateDirectiveName(alias); err != nil {
+					return err
+				}
+				d.aliases = append(d.aliases, alias)
+			}
+		default:
+			return fmt.Errorf("unknown key %q", key)
+		}
+	}
+
+	return nil
+}
+
+// validateDirectiveName checks that name can be used as
+// the external representation of a constant.
+func validateDirectiveName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	// Scan() trims the names it reads, so surrounding spaces could not be parsed.
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("name %q cannot start or end with a space", name)
+	}
+
+	for _, r := range name {
+		if r == '"' || r == '\\' || r == '|' || !unicode.IsPrint(r) {
+			return fmt.Errorf("name %q cannot contain %q", name, r)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,356 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+)
+
+// findTypeDeclByPosition finds the next *type.TypeName in inputFileName after line
+func findTypeDeclByPosition(fset *token.FileSet, info *types.Info, inputFileName string, line int) (*types.TypeName, error) {
+	var ret *types.TypeName
+	var closestObject types.Object
+	closest := math.MaxInt32
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		p := fset.Position(object.Pos())
+		same, err := sameFile(p.Filename, inputFileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine type: %w", err)
+		}
+
+		if !same {
+			continue
+		}
+
+		if p.Line < line || closest < p.Line {
+			continue
+		}
+
+		ret = nil // we found something closer than our current closest thing
+		closestObject = object
+
+		c, ok := object.(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		ret = c
+		closest = p.Line
+	}
+
+	if ret == nil {
+		if closestObject != nil {
+			return nil, fmt.Errorf("failed to determine type: closest declaration is not a named type: %v", closestObject)
+		}
+		return nil, fmt.Errorf("failed to determine type")
+	}
+
+	return ret, nil
+}
+
+// findTypeDeclByName finds the the *types.TypeName in info named name.
+func findTypeDeclByName(info *types.Info, name string) (*types.TypeName, error) {
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		c, ok := object.(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		if c.Name() != name {
+			continue
+		}
+
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("type %q not found", name)
+}
+
+// findEnumTypes finds all package-level named types in pkg that have at
+// least one constant declared in info. The types are returned in the
+// order they show up in source code.
+func findEnumTypes(fset *token.FileSet, info *types.Info, pkg *types.Package) []*types.TypeName {
+	var ret []*types.TypeName
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+
+		// Types whose constants cannot be used are still returned,
+		// so that the error is reported when code is generated for them.
+		if cs, _, err := findConstantsOfType(fset, info, tn); len(cs) == 0 && err == nil {
+			continue
+		}
+
+		ret = append(ret, tn)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		ip := fset.Position(ret[i].Pos())
+		jp := fset.Position(ret[j].Pos())
+
+		return ip.Filename < jp.Filename ||
+			ip.Filename == jp.Filename && ip.Offset < jp.Offset
+	})
+
+	return ret
+}
+
+// findConstantsOfType finds all constants in info that are of type obj, and the kind of their values.
+// An error is returned if the values of the constants are not all of the same kind.
+func findConstantsOfType(fset *token.FileSet, info *types.Info, obj types.Object) ([]*types.Const, constant.Kind, error) {
+	var ret []*types.Const
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		c, ok := object.(*types.Const)
+		if !ok {
+			continue
+		}
+
+		t, ok := c.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+
+		if c.Name() == "_" {
+			continue
+		}
+
+		if t.Obj() != obj {
+			continue
+		}
+
+		ret = append(ret, c)
+	}
+
+	if len(ret) == 0 {
+		return nil, constant.Unknown, nil
+	}
+
+	// Sort the items based on where they show up in source code.
+	// This is mainly to avoid significant differences in version control overtime.
+	sort.Slice(ret, func(i, j int) bool {
+		ip := fset.Position(ret[i].Pos())
+		jp := fset.Position(ret[j].Pos())
+
+		return ip.Filename < jp.Filename ||
+			ip.Filename == jp.Filename && ip.Offset < jp.Offset
+	})
+
+	// The first constant declared determines the kind that the others are expected to have.
+	kind := ret[0].Val().Kind()
+	for _, c := range ret {
+		switch k := c.Val().Kind(); {
+		case k == constant.Unknown:
+			return nil, constant.Unknown, fmt.Errorf("%s: constant %s has an unknown value", fset.Position(c.Pos()), c.Name())
+		case k != kind:
+			return nil, constant.Unknown, fmt.Errorf("%s: constant %s has kind %s, expected %s like %s", fset.Position(c.Pos()), c.Name(), k, kind, ret[0].Name())
+		}
+	}
+
+	return ret, kind, nil
+}
+
+// enumConstant is a constant of an enum type, along with its
+// representation in String(), Bytes(), Scan() etc.
+type enumConstant struct {
+	*types.Const
+	// text is the external representation of the constant.
+	text string
+	// aliases are additional names that are accepted when parsing,
+	// but never returned by String().
+	aliases []string
+}
+
+// str returns what String() returns for c: the value of a string enum, and the text of other enums.
+func (c *enumConstant) str() string {
+	if c.Val().Kind() == constant.String {
+		return constant.StringVal(c.Val())
+	}
+	return c.text
+}
+
+// newEnumConstants wraps cs, using opts.names to determine the text of each constant.
+// Names set with comment directives take precedence over opts.names.
+// An error is returned if a name is used by more than one constant.
+//
+// Since String() returns the value of string-kind enums, their values are
+// accepted when parsing as well, unless they are the name of another constant.
+func newEnumConstants(fset *token.FileSet, cs []*types.Const, opts enumOptions, directives map[*types.Const]constantDirective) ([]*enumConstant, error) {
+	fold := func(name string) string {
+		if opts.ignoreCase {
+			return strings.ToLower(name)
+		}
+		return name
+	}
+
+	ret := make([]*enumConstant, 0, len(cs))
+	seen := make(map[string]*types.Const, len(cs))
+	for _, c := range cs {
+		d := directives[c]
+		ec := &enumConstant{Const: c, text: opts.names.apply(c.Name()), aliases: d.aliases}
+		if d.name != "" {
+			ec.text = d.name
+		}
+
+		// Transformed names can be empty, e.g. for a constant named "_X" with --trim-prefix _X.
+		if ec.text == "" {
+			return nil, fmt.Errorf("%s: the name of constant %s is empty", fset.Position(c.Pos()), c.Name())
+		}
+
+		for _, name := range append([]string{ec.text}, ec.aliases...) {
+			key := fold(name)
+			if other, ok := seen[key]; ok && other != c {
+				return nil, fmt.Errorf("%s: constants %s and %s both use the name %q", fset.Position(c.Pos()), other.Name(), c.Name(), name)
+			}
+			seen[key] = c
+		}
+
+		ret = append(ret, ec)
+	}
+
+	for _, ec := range ret {
+		if ec.Val().Kind() != constant.String {
+			continue
+		}
+
+		value := constant.StringVal(ec.Val())
+		if _, ok := seen[fold(value)]; ok || value == "" {
+			continue
+		}
+
+		seen[fold(value)] = ec.Const
+		ec.aliases = append(ec.aliases, value)
+	}
+
+	return ret, nil
+}
+
+// uniqueConstants returns the constants in cs that have distinct values.
+// When multiple constants share a value, the first one in cs is considered
+// the canonical constant, and the others are considered aliases of it.
+func uniqueConstants(cs []*enumConstant) []*enumConstant {
+	var ret []*enumConstant
+	for _, c := range cs {
+		if canonicalConstant(ret, c) != nil {
+			continue
+		}
+
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+// canonicalConstant returns the constant in cs that has the same value as c.
+// If there is no such constant, nil is returned.
+func canonicalConstant(cs []*enumConstant, c *enumConstant) *enumConstant {
+	for _, u := range cs {
+		if constant.Compare(u.Val(), token.EQL, c.Val()) {
+			return u
+		}
+	}
+	return nil
+}
+
+// sameFile determines if a and b point to the same file
+func sameFile(a, b string) (bool, error) {
+	as, err := os.Stat(a)
+	if err != nil {
+		return false, err
+	}
+
+	bs, err := os.Stat(b)
+	if err != nil {
+		return false, err
+	}
+
+	return os.SameFile(as, bs), nil
+}
+
+// enumOptions holds the options that control the code generated for an enum.
+type enumOptions struct {
+	// receiver is the receiver name of the generated methods.
+	// If empty, the default receiver name for the type is used.
+	receiver string
+	// flags determines if the enum is a set of bit flags.
+	// If nil, it is detected from the constant values.
+	flags *bool
+	// sql determines how the enum is stored in a database.
+	// If empty, database/sql support is not generated.
+	sql sqlMode
+	// names determines how the names of constants are transformed
+	// into their external representation.
+	names nameTransform
+	// ignoreCase determines if names are matched case-insensitively when parsing.
+	ignoreCase bool
+	// layout determines how String() and Bytes() look up the names of values.
+	layout stringLayout
+	// tests determines if tests are generated for the enum.
+	tests bool
+	// fuzz determines if fuzz targets are generated for the enum.
+	fuzz bool
+	// iterators determines if functions returning iter.Seq are generated.
+	// It is determined from the Go version of the module being generated for.
+	iterators bool
+	// methods are the methods selected in Options.Methods. If nil, every method is selected.
+	// Once resolved, it holds exactly the methods that are generated.
+	methods methodSet
+	// skip are the methods deselected in Options.Skip.
+	skip methodSet
+	// existing are the methods that the type already declares. They are resolved with methods.
+	existing methodSet
+	// output is the name of the file the code is written to. Declarations
+	// in it are replaced, so they do not prevent methods from being generated.
+	output string
+	// importPath is the import path of the package of an imported enum type. The code for
+	// imported types is generated as functions, since methods cannot be declared on them.
+	// It is empty for types declared in the package being generated for.
+	importPath string
+	// closestNames is the name of the function that finds the suggestions of parse errors.
+	// It is shared by every enum in a file, so it is set by File.Add.
+	closestNames string
+}
+
+// defaultReceiverName returns the default receiver name to use for tn
+func defaultReceiverName(tn *types.TypeName) string {
+	s, _ := utf8.DecodeRuneInString(tn.Name())
+	return ident.Unexported(string(s))
+}
+
+// safeIndent returns an identifier that is safe to use (not a keyword,
+// and not already used). want is the requested identifier; not is a
+// list of identifiers that are already used.
+func safeIndent(want string, not ...string) string {
+	if token.IsKeyword(want) {
+		return safeIndent("_"+want, not...)
+	}
+
+	for _, s := range not {
+		if want == s {
+			return safeIndent("_"+want, not...)
+		}
+	}
+
+	return want
+}
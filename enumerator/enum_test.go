@@ -0,0 +1,96 @@
+package enumerator
+
+import (
+	"go/constant"
+	"go/types"
+	"testing"
+)
+
+func TestNewEnum_PositionedErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		opts    Options
+		wantErr string
+	}{
+		{
+			"unknown value",
+			`const (
+	A T = 1
+	B T = missing
+)`,
+			Options{},
+			"testdata/t.go:7:2: constant B has an unknown value",
+		},
+		{
+			"empty name",
+			`const (
+	T_ T = iota
+	TA
+)`,
+			Options{TrimPrefix: "T", Transform: "snake"},
+			"testdata/t.go:6:2: the name of constant T_ is empty",
+		},
+		{
+			"duplicate name",
+			`const (
+	A T = iota // enum:"x"
+	B          // enum:"x"
+)`,
+			Options{},
+			`testdata/t.go:7:2: constants A and B both use the name "x"`,
+		},
+		{
+			"duplicate transformed name",
+			`const (
+	DarkBlue T = iota
+	Dark_Blue
+)`,
+			Options{Transform: "snake"},
+			`testdata/t.go:7:2: constants DarkBlue and Dark_Blue both use the name "dark_blue"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadTestPackage(t, map[string]string{"t.go": "package t\n\ntype T int\n\n" + tt.src + "\n"})
+
+			_, err := NewEnum(pkg, "T", tt.opts)
+			if err == nil {
+				t.Fatalf("NewEnum() error = nil, want %q", tt.wantErr)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("NewEnum() error = %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindConstantsOfType_MixedKinds(t *testing.T) {
+	pkg := loadTestPackage(t, map[string]string{"t.go": `package t
+
+type T float64
+
+const (
+	A T = 1.5
+	B T = 2.5
+)
+`})
+	tn, err := findTypeDeclByName(pkg.TypesInfo, "T")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The values of typed constants always have the kind of their type,
+	// so B is replaced with a constant of the same type that holds an int.
+	for id, obj := range pkg.TypesInfo.Defs {
+		if obj != nil && obj.Name() == "B" {
+			pkg.TypesInfo.Defs[id] = types.NewConst(obj.Pos(), obj.Pkg(), "B", obj.Type(), constant.MakeInt64(2))
+		}
+	}
+
+	_, _, err = findConstantsOfType(pkg.Fset, pkg.TypesInfo, tn)
+	want := "testdata/t.go:7:2: constant B has kind Int, expected Float like A"
+	if err == nil || err.Error() != want {
+		t.Errorf("findConstantsOfType() error = %v, want %q", err, want)
+	}
+}
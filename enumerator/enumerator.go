@@ -0,0 +1,487 @@
+// Package enumerator generates enum-like code for Go constants. It is the
+// library behind the go-enumerator command, for use by other code generators
+// and build tools.
+//
+// Load a package, then generate the code for one of its types:
+//
+//	pkg, err := enumerator.Load("./example")
+//	if err != nil {
+//		return err
+//	}
+//
+//	src, err := enumerator.Generate(pkg, "Kind", enumerator.Options{})
+//
+// To inspect an enum before generating code, or to generate the code for
+// several enums into a single file, use NewEnum and File instead.
+package enumerator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"golang.org/x/tools/go/packages"
+)
+
+// toolName is the name of the tool, as it appears in generated comments.
+const toolName = "go-enumerator"
+
+// LoadMode is the packages.LoadMode that packages passed to NewEnum must be loaded with.
+const LoadMode = packages.NeedName | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedDeps | packages.NeedImports | packages.NeedModule
+
+// Load loads the Go package in directory dir with LoadMode.
+func Load(dir string) (*packages.Package, error) {
+	return load(dir, ".")
+}
+
+// load loads the Go package matching pattern with LoadMode, running the build tool in directory dir.
+func load(dir, pattern string) (*packages.Package, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: LoadMode, Dir: dir}, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("found %d packages matching %s, expected 1", len(pkgs), pattern)
+	}
+
+	if len(pkgs[0].Errors) > 0 {
+		return nil, pkgs[0].Errors[0]
+	}
+
+	return pkgs[0], nil
+}
+
+// Options control the code generated for an enum.
+// The zero value generates every method with the default settings.
+type Options struct {
+	// Receiver is the receiver name of the generated methods.
+	// If empty, the first letter of the type is used.
+	Receiver string
+	// Flags determines if the enum is a set of bit flags.
+	// If nil, it is detected from the constant values.
+	Flags *bool
+	// TrimPrefix is trimmed from constant names when converting them to and from strings.
+	TrimPrefix string
+	// Transform is the case transformation applied to constant names when converting them
+	// to and from strings. One of "snake", "kebab", "lower", "upper", "camel" or "title".
+	// If empty, names are not transformed. TrimPrefix and Transform cannot be used with string
+	// enums, whose values are used as their names.
+	Transform string
+	// IgnoreCase determines if names are matched case-insensitively when parsing.
+	IgnoreCase bool
+	// Layout determines how String() and Bytes() look up the names of values.
+	// One of "table" or "switch". If empty, "table" is used.
+	Layout string
+	// Methods are the names of the methods to generate, as returned by MethodNames.
+	// The groups "json", "text" and "sql" stand for both of their methods.
+	// If nil, every method is generated.
+	Methods []string
+	// Skip are the names of the methods not to generate. It accepts the same names as Methods.
+	Skip []string
+	// SQL determines how the enum is stored in a database. One of "name" or "value".
+	// If empty, database/sql support is not generated.
+	SQL string
+	// Tests determines if tests are generated for the enum. See File.TestSource.
+	Tests bool
+	// Fuzz determines if fuzz targets are generated for the enum. See File.TestSource.
+	Fuzz bool
+	// Output is the name of the file that the code will be written to, if any.
+	// Declarations in it are replaced, so they do not prevent methods from being generated.
+	Output string
+}
+
+// newEnumOptions parses o.
+func newEnumOptions(o Options) (enumOptions, error) {
+	opts := enumOptions{
+		receiver:   o.Receiver,
+		flags:      o.Flags,
+		ignoreCase: o.IgnoreCase,
+		tests:      o.Tests,
+		fuzz:       o.Fuzz,
+		output:     o.Output,
+	}
+
+	var err error
+	opts.names.trimPrefix = o.TrimPrefix
+	opts.names.caseTransform, err = parseCaseTransform(o.Transform)
+	if err != nil {
+		return opts, err
+	}
+
+	if o.Layout != "" {
+		opts.layout, err = parseStringLayout(o.Layout)
+		if err != nil {
+			return opts, err
+		}
+	}
+
+	opts.sql, err = parseSqlMode(o.SQL)
+	if err != nil {
+		return opts, err
+	}
+
+	if o.Methods != nil {
+		opts.methods, err = parseMethodSet(o.Methods)
+		if err != nil {
+			return opts, err
+		}
+	}
+
+	opts.skip, err = parseMethodSet(o.Skip)
+	if err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// Enum is the model of an enum that code is generated from.
+type Enum struct {
+	// Type is the enum type.
+	Type *types.TypeName
+	// Kind is the kind of the values of the constants.
+	Kind constant.Kind
+	// Flags is true if the enum is a set of bit flags.
+	Flags bool
+	// Constants are the constants of the enum, in the order they are declared.
+	Constants []Constant
+	// Methods are the names of the methods that are generated, in the order they are generated.
+	// Methods that the type already declares are not included.
+	Methods []string
+
+	cs   []*enumConstant
+	opts enumOptions
+}
+
+// Constant is a constant of an enum.
+type Constant struct {
+	*types.Const
+	// Text is the name of the constant returned by String(), and accepted by Scan().
+	Text string
+	// Aliases are additional names that are accepted when parsing, but never returned by String().
+	Aliases []string
+	// AliasOf is the name of the first constant declared with the same value.
+	// It is empty if this is the first constant declared with its value.
+	AliasOf string
+}
+
+// NewEnum builds the model of the enum type named typeName in pkg.
+// pkg must be loaded with LoadMode.
+//
+// typeName can also be the name of a type in another package, qualified by its import path,
+// such as "example.com/pkg.Kind". Methods cannot be declared on imported types, so the code
+// for them is generated as functions in pkg, such as KindString(), ParseKind() and KindValues().
+// Only the String, Defined, Parse, MustParse, Next, Values and Check methods are generated,
+// and tests are not supported. Unexported constants of imported types are left out.
+func NewEnum(pkg *packages.Package, typeName string, o Options) (*Enum, error) {
+	opts, err := newEnumOptions(o)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.iterators = supportsIterators(pkg)
+
+	// The generated code is declared in target, even if the type is imported from another package.
+	target := pkg.Types
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		if typeName[:i] != pkg.PkgPath {
+			opts.importPath = typeName[:i]
+			pkg, err = findImport(pkg, opts.importPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typeName = typeName[i+1:]
+	}
+
+	if opts.importPath != "" {
+		if opts.tests || opts.fuzz {
+			return nil, fmt.Errorf("type %s.%s: tests cannot be generated for imported types", opts.importPath, typeName)
+		}
+
+		if opts.flags != nil && *opts.flags {
+			return nil, fmt.Errorf("type %s.%s: imported types cannot be used as bit flags", opts.importPath, typeName)
+		}
+
+		// Bit flags are not detected for imported types, so
+		// their constants are treated as single values.
+		flags := false
+		opts.flags = &flags
+	}
+
+	tn, err := findTypeDeclByName(pkg.TypesInfo, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.importPath != "" && !tn.Exported() {
+		return nil, fmt.Errorf("type %s.%s is not exported", opts.importPath, typeName)
+	}
+
+	if opts.receiver == "" {
+		opts.receiver = defaultReceiverName(tn)
+	}
+	opts.receiver = safeIndent(opts.receiver)
+
+	pos := pkg.Fset.Position(tn.Pos())
+	vs, kind, err := findConstantsOfType(pkg.Fset, pkg.TypesInfo, tn)
+	if err != nil {
+		return nil, err
+	}
+
+	// The generated code cannot refer to the unexported constants of another package.
+	if opts.importPath != "" {
+		exported := vs[:0]
+		for _, c := range vs {
+			if c.Exported() {
+				exported = append(exported, c)
+			}
+		}
+		vs = exported
+	}
+
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("%s: no constants of type %q found", pos, tn.Name())
+	}
+
+	// String() returns the values of string enums, so transformed names would only be accepted when parsing.
+	if kind == constant.String && opts.names != (nameTransform{}) {
+		return nil, fmt.Errorf("%s: names of type %q cannot be trimmed or transformed: String() returns the values of string enums", pos, tn.Name())
+	}
+
+	directives, err := findConstantDirectives(pkg.Fset, pkg.Syntax, pkg.TypesInfo, vs)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == constant.String {
+		for _, c := range vs {
+			if directives[c].name != "" {
+				return nil, fmt.Errorf("%s: the name of constant %s cannot be overridden: String() returns the values of string enums", pkg.Fset.Position(c.Pos()), c.Name())
+			}
+		}
+	}
+
+	cs, err := newEnumConstants(pkg.Fset, vs, opts, directives)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.flags == nil {
+		flags := isBitFlags(cs, kind, findShiftedConstants(pkg.Syntax, pkg.TypesInfo, vs))
+		opts.flags = &flags
+	}
+
+	if *opts.flags && kind != constant.Int {
+		return nil, fmt.Errorf("%s: type %q cannot be used as bit flags: constants are not integers", pos, tn.Name())
+	}
+
+	if opts.sql == sqlValue && kind == constant.Complex {
+		return nil, fmt.Errorf("%s: type %q cannot be stored by value: complex values are not supported by database/sql", pos, tn.Name())
+	}
+
+	if opts.sql == sqlValue && kind == constant.Int {
+		// database/sql stores integers as int64, so larger uint64 values would wrap around.
+		for _, c := range cs {
+			if constant.Compare(c.Val(), token.GTR, constant.MakeInt64(math.MaxInt64)) {
+				return nil, fmt.Errorf("%s: type %q cannot be stored by value: constant %s overflows int64, store names instead", pkg.Fset.Position(c.Pos()), tn.Name(), c.Name())
+			}
+		}
+	}
+
+	opts.methods, opts.existing, err = selectMethods(pkg.Fset, target, tn, kind, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &Enum{Type: tn, Kind: kind, Flags: *opts.flags, cs: cs, opts: opts}
+	for _, c := range cs {
+		ec := Constant{Const: c.Const, Text: c.text, Aliases: c.aliases}
+		if u := canonicalConstant(cs, c); u != c {
+			ec.AliasOf = u.Name()
+		}
+		ret.Constants = append(ret.Constants, ec)
+	}
+
+	for _, m := range allMethods {
+		if opts.methods[m] {
+			ret.Methods = append(ret.Methods, string(m))
+		}
+	}
+
+	return ret, nil
+}
+
+// File is a Go source file that holds the code generated for one or more enums,
+// along with the test file that holds their tests.
+type File struct {
+	pkgName string
+	header  []string
+	f, tf   *jen.File
+	// closestNames is the name of the function shared by the parse errors of the enums in f.
+	// It is empty until an enum that needs it is added.
+	closestNames string
+}
+
+// NewFile creates a file in the package named pkgName.
+// Each line of header becomes a line of the comment at the top of the file.
+func NewFile(pkgName string, header ...string) *File {
+	return &File{pkgName: pkgName, header: header, f: newEnumFile(pkgName, header)}
+}
+
+// Add generates the code for e into f.
+// e must have been created by NewEnum.
+func (f *File) Add(e *Enum) error {
+	if e.cs == nil {
+		return errors.New("enum was not created by NewEnum")
+	}
+
+	opts := e.opts
+	closestNames := opts.methods[methodParse] && f.closestNames == ""
+	if closestNames {
+		f.closestNames = closestNamesFuncName(e.Type)
+	}
+	opts.closestNames = f.closestNames
+
+	if opts.importPath != "" {
+		generateCompanionCode(f.f, e.Type, e.cs, e.Kind, opts)
+	} else {
+		generateEnumCode(f.f, e.Type, e.cs, e.Kind, opts)
+	}
+
+	if closestNames {
+		f.f.Line()
+		generateClosestNamesFunction(f.f, f.closestNames)
+	}
+
+	// Tests are never generated for imported types.
+	if !opts.tests && !opts.fuzz {
+		return nil
+	}
+
+	if f.tf == nil {
+		f.tf = newEnumFile(f.pkgName, f.header)
+	}
+
+	generateEnumTests(f.tf, e.Type, e.cs, e.Kind, opts)
+	return nil
+}
+
+// Source returns the formatted source code of f.
+func (f *File) Source() ([]byte, error) {
+	var buf bytes.Buffer
+	err := f.f.Render(&buf)
+	return buf.Bytes(), err
+}
+
+// TestSource returns the formatted source code of the test file of f.
+// If no enum in f requested tests or fuzz targets, nil is returned.
+func (f *File) TestSource() ([]byte, error) {
+	if f.tf == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	err := f.tf.Render(&buf)
+	return buf.Bytes(), err
+}
+
+// Generate returns the formatted source code generated for the enum type named typeName in pkg.
+// Use File to get the tests requested with Options.Tests and Options.Fuzz as well.
+func Generate(pkg *packages.Package, typeName string, o Options) ([]byte, error) {
+	e, err := NewEnum(pkg, typeName, o)
+	if err != nil {
+		return nil, err
+	}
+
+	f := NewFile(pkg.Name, fmt.Sprintf("Code generated by %s; DO NOT EDIT.", toolName))
+	err = f.Add(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Source()
+}
+
+// findImport returns the package with import path path that pkg depends on.
+// If pkg does not depend on it, the package is loaded from the directory of pkg.
+func findImport(pkg *packages.Package, path string) (*packages.Package, error) {
+	seen := make(map[*packages.Package]bool)
+	var find func(p *packages.Package) *packages.Package
+	find = func(p *packages.Package) *packages.Package {
+		if seen[p] {
+			return nil
+		}
+		seen[p] = true
+
+		if ret, ok := p.Imports[path]; ok {
+			return ret
+		}
+
+		for _, ip := range p.Imports {
+			if ret := find(ip); ret != nil {
+				return ret
+			}
+		}
+		return nil
+	}
+
+	if ret := find(pkg); ret != nil && ret.TypesInfo != nil {
+		return ret, nil
+	}
+
+	var dir string
+	if len(pkg.Syntax) > 0 {
+		dir = filepath.Dir(pkg.Fset.File(pkg.Syntax[0].Pos()).Name())
+	}
+
+	return load(dir, path)
+}
+
+// GenerateDir is like Generate, but loads the package in directory dir first.
+func GenerateDir(dir, typeName string, o Options) ([]byte, error) {
+	pkg, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return Generate(pkg, typeName, o)
+}
+
+// EnumTypes returns the names of the named types in pkg that have at least
+// one constant declared, in the order they are declared.
+func EnumTypes(pkg *packages.Package) []string {
+	var ret []string
+	for _, tn := range findEnumTypes(pkg.Fset, pkg.TypesInfo, pkg.Types) {
+		ret = append(ret, tn.Name())
+	}
+	return ret
+}
+
+// TypeAfterLine returns the name of the type that is declared first on or after line in the file named fileName,
+// which is the type that a //go:generate comment on the line before refers to. An error is returned
+// if the first declaration on or after line is not a type.
+func TypeAfterLine(pkg *packages.Package, fileName string, line int) (string, error) {
+	tn, err := findTypeDeclByPosition(pkg.Fset, pkg.TypesInfo, fileName, line)
+	if err != nil {
+		return "", err
+	}
+	return tn.Name(), nil
+}
+
+// MethodNames returns the names of the methods that can be generated, in the order they are generated.
+func MethodNames() []string {
+	ret := make([]string, len(allMethods))
+	for i, m := range allMethods {
+		ret[i] = string(m)
+	}
+	return ret
+}
@@ -0,0 +1,194 @@
+package enumerator
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadTestPackage type-checks the package made of files, which map file names to their source,
+// the way packages.Load does with LoadMode. Imports are limited to the standard library, and
+// type errors are reported in the Errors of the package, as packages.Load does.
+// The files are not written to disk, so their names are only used in positions.
+func loadTestPackage(t *testing.T, files map[string]string) *packages.Package {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	var syntax []*ast.File
+	for _, name := range sortedKeys(files) {
+		f, err := parser.ParseFile(fset, filepath.Join("testdata", name), files[name], parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		syntax = append(syntax, f)
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	// Like packages.Load, type errors are reported in Errors rather than failing.
+	var errs []packages.Error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			errs = append(errs, packages.Error{Msg: err.Error(), Kind: packages.TypeError})
+		},
+	}
+	pkg, _ := conf.Check("example.com/"+syntax[0].Name.Name, fset, syntax, info)
+
+	return &packages.Package{
+		Name:      pkg.Name(),
+		PkgPath:   pkg.Path(),
+		Fset:      fset,
+		Syntax:    syntax,
+		Types:     pkg,
+		TypesInfo: info,
+		Errors:    errs,
+		Module:    &packages.Module{GoVersion: "1.18"},
+	}
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]string) []string {
+	var ret []string
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerateDir(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   Options
+		golden string
+	}{
+		{"table", Options{}, "weekday_enum.golden"},
+		{"switch", Options{Layout: "switch", Transform: "snake", SQL: "name"}, "weekday_switch_enum.golden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateDir(filepath.Join("testdata", "golden"), "Weekday", tt.opts)
+			if err != nil {
+				t.Fatalf("GenerateDir() error = %v", err)
+			}
+
+			path := filepath.Join("testdata", "golden", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("GenerateDir() does not match %s; run go test -update to update it", path)
+			}
+		})
+	}
+}
+
+func TestFile(t *testing.T) {
+	src := `package t
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+type Size string
+
+const (
+	Small Size = "S"
+	Large Size = "L"
+)
+`
+	pkg := loadTestPackage(t, map[string]string{"t.go": src})
+
+	f := NewFile(pkg.Name, "Code generated for testing.", "DO NOT EDIT.")
+	for _, tt := range []struct {
+		typeName string
+		opts     Options
+	}{
+		{"Color", Options{Tests: true}},
+		{"Size", Options{}},
+	} {
+		e, err := NewEnum(pkg, tt.typeName, tt.opts)
+		if err != nil {
+			t.Fatalf("NewEnum(%q) error = %v", tt.typeName, err)
+		}
+		if err := f.Add(e); err != nil {
+			t.Fatalf("Add(%q) error = %v", tt.typeName, err)
+		}
+	}
+
+	code, err := f.Source()
+	if err != nil {
+		t.Fatalf("Source() error = %v", err)
+	}
+	test, err := f.TestSource()
+	if err != nil {
+		t.Fatalf("TestSource() error = %v", err)
+	}
+
+	if !strings.HasPrefix(string(code), "// Code generated for testing.\n// DO NOT EDIT.\n") {
+		t.Errorf("Source() does not start with the header:\n%s", code)
+	}
+	for _, want := range []string{"func (c Color) String() string", "func (s Size) String() string"} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("Source() does not contain %q", want)
+		}
+	}
+	// The function that finds suggestions for parse errors is shared by every type in a file.
+	if n := strings.Count(string(code), "ClosestNames(input string"); n != 1 {
+		t.Errorf("Source() declares %d ClosestNames functions, want 1", n)
+	}
+	// Only Color requested tests.
+	if !strings.Contains(string(test), "func TestColor_") || strings.Contains(string(test), "func TestSize_") {
+		t.Errorf("TestSource() does not test only Color:\n%s", test)
+	}
+
+	checked := loadTestPackage(t, map[string]string{"t.go": src, "t_enum.go": string(code), "t_enum_test.go": string(test)})
+	for _, err := range checked.Errors {
+		t.Errorf("generated code does not compile: %v", err)
+	}
+
+	// A file without tests has no test source.
+	f = NewFile(pkg.Name)
+	e, err := NewEnum(pkg, "Size", Options{})
+	if err != nil {
+		t.Fatalf("NewEnum() error = %v", err)
+	}
+	if err := f.Add(e); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if test, err := f.TestSource(); test != nil || err != nil {
+		t.Errorf("TestSource() = %q, %v, want nil, nil", test, err)
+	}
+
+	if err := f.Add(&Enum{}); err == nil {
+		t.Error("Add() of an enum not created by NewEnum succeeded")
+	}
+}
@@ -0,0 +1,170 @@
+package enumerator
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// errVarName returns the name of the generated sentinel error that parse errors of tn match.
+func errVarName(tn *types.TypeName) string {
+	return packageFuncName("ErrInvalid", tn)
+}
+
+// errTypeName returns the name of the generated error type that is returned when tn values cannot be parsed.
+func errTypeName(tn *types.TypeName) string {
+	return packageFuncName("Invalid", tn) + "Error"
+}
+
+// validNames returns the names of the distinct values of cs as a human-readable list,
+// in the form String() returns them.
+func validNames(cs []*enumConstant) string {
+	cs = uniqueConstants(cs)
+	names := make([]string, len(cs))
+	for i, c := range cs {
+		names[i] = c.str()
+	}
+
+	switch len(names) {
+	case 1:
+		return names[0]
+	default:
+		return "one of " + strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+	}
+}
+
+// parseNames returns the names that are accepted when parsing cs, including aliases, in the order they are declared.
+func parseNames(cs []*enumConstant) []string {
+	var ret []string
+	seen := make(map[string]bool)
+	for _, c := range cs {
+		for _, name := range append([]string{c.text}, c.aliases...) {
+			if !seen[name] {
+				seen[name] = true
+				ret = append(ret, name)
+			}
+		}
+	}
+	return ret
+}
+
+// closestNamesFuncName returns the name of the function that the Suggestions() methods of the parse errors in a
+// file call. It is named after tn, the first type in the file that needs it.
+func closestNamesFuncName(tn *types.TypeName) string {
+	return ident.Unexported(tn.Name()) + "ClosestNames"
+}
+
+// newParseError returns the expression that creates the error returned by Parse<Type>() for input.
+func newParseError(tn *types.TypeName, input jen.Code) *jen.Statement {
+	return jen.Op("&").Id(errTypeName(tn)).Values(jen.Dict{jen.Id("Input"): input})
+}
+
+// generateParseErrors generates the Invalid<Type>Error type returned by Parse<Type>(), and the
+// ErrInvalid<Type> sentinel that it matches with errors.Is. The message of the error lists the
+// names of the defined values, so that it can be shown to users as is. closestNames is the name
+// of the function generated by generateClosestNamesFunction, which finds the suggestions.
+func generateParseErrors(f *jen.File, tn *types.TypeName, cs []*enumConstant, flags bool, closestNames string) {
+	f.Commentf("%s is matched by the errors returned when a %s cannot be parsed. Use errors.Is to check for it.", errVarName(tn), tn.Name())
+	f.Var().Id(errVarName(tn)).Op("=").Qual("errors", "New").Call(jen.Lit("invalid " + tn.Name()))
+
+	input := "Input is the string that could not be parsed."
+	if flags {
+		input = "Input is the flag name that could not be parsed."
+	}
+
+	f.Line()
+	f.Commentf("%s is the error returned when a string is not the name of a %s.", errTypeName(tn), tn.Name())
+	f.Commentf("It matches %s with errors.Is. Use errors.As to get the input that was rejected.", errVarName(tn))
+	f.Type().Id(errTypeName(tn)).Struct(
+		jen.Comment(input),
+		jen.Id("Input").String(),
+	)
+
+	f.Line()
+	f.Comment("Error implements error. The message lists the names of the defined values,")
+	f.Comment("followed by the names returned by Suggestions(), if there are any.")
+	f.Func().Params(jen.Id("e").Op("*").Id(errTypeName(tn))).Id("Error").Params().String().Block(
+		jen.Id("msg").Op(":=").Qual("fmt", "Sprintf").Call(jen.Lit("invalid "+tn.Name()+" %q: must be "+strings.ReplaceAll(validNames(cs), "%", "%%")), jen.Id("e").Dot("Input")),
+		jen.If(jen.Id("s").Op(":=").Id("e").Dot("Suggestions").Call(), jen.Len(jen.Id("s")).Op(">").Lit(0)).Block(
+			jen.Id("msg").Op("+=").Lit(" (did you mean ").Op("+").Qual("strings", "Join").Call(jen.Id("s"), jen.Lit(" or ")).Op("+").Lit("?)"),
+		),
+		jen.Return(jen.Id("msg")),
+	)
+
+	f.Line()
+	generateSuggestionsMethod(f, tn, cs, closestNames)
+
+	f.Line()
+	f.Commentf("Is returns true if target is %s.", errVarName(tn))
+	f.Func().Params(jen.Id("e").Op("*").Id(errTypeName(tn))).Id("Is").Params(jen.Id("target").Error()).Bool().Block(
+		jen.Return(jen.Id("target").Op("==").Id(errVarName(tn))),
+	)
+}
+
+// generateSuggestionsMethod generates the Suggestions() method of the Invalid<Type>Error type. The names that are
+// accepted when parsing are known when the code is generated, so they are passed to closestNames as literals.
+func generateSuggestionsMethod(f *jen.File, tn *types.TypeName, cs []*enumConstant, closestNames string) {
+	f.Comment("Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are")
+	f.Comment("compared case-insensitively, and only names that differ from e.Input by a few characters are returned.")
+	f.Func().Params(jen.Id("e").Op("*").Id(errTypeName(tn))).Id("Suggestions").Params().Index().String().Block(
+		jen.Return(jen.Id(closestNames).Call(jen.Id("e").Dot("Input"), jen.Index().String().ValuesFunc(func(g *jen.Group) {
+			for _, name := range parseNames(cs) {
+				g.Lit(name)
+			}
+		}))),
+	)
+}
+
+// generateClosestNamesFunction generates the function named name, which returns the names that are closest to
+// an input. It is generated once per file, and shared by the Suggestions() methods of every type in the file.
+// Names are compared case-insensitively by their edit distance from the input, and only the closest names that
+// are at most a third of their length away are returned. This catches typos without suggesting unrelated names.
+func generateClosestNamesFunction(f *jen.File, name string) {
+	f.Commentf("%s returns the names that input is closest to, in the order they are given. Names are compared", name)
+	f.Comment("case-insensitively by their edit distance from input, and names more than a third of their length away are left out.")
+	f.Func().Id(name).Params(jen.Id("input").String(), jen.Id("names").Index().String()).Index().String().Block(
+		jen.Id("in").Op(":=").Index().Rune().Call(jen.Qual("strings", "ToLower").Call(jen.Id("input"))),
+		jen.Var().Id("ret").Index().String(),
+		jen.Id("best").Op(":=").Lit(-1),
+		jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names")).Block(
+			jen.Id("target").Op(":=").Index().Rune().Call(jen.Qual("strings", "ToLower").Call(jen.Id("name"))),
+			jen.Comment("prev and cur are consecutive rows of the edit distance matrix."),
+			jen.Id("prev").Op(":=").Make(jen.Index().Int(), jen.Len(jen.Id("target")).Op("+").Lit(1)),
+			jen.Id("cur").Op(":=").Make(jen.Index().Int(), jen.Len(jen.Id("target")).Op("+").Lit(1)),
+			jen.For(jen.Id("j").Op(":=").Range().Id("prev")).Block(
+				jen.Id("prev").Index(jen.Id("j")).Op("=").Id("j"),
+			),
+			jen.For(jen.Id("i").Op(":=").Range().Id("in")).Block(
+				jen.Id("cur").Index(jen.Lit(0)).Op("=").Id("i").Op("+").Lit(1),
+				jen.For(jen.Id("j").Op(":=").Range().Id("target")).Block(
+					jen.Id("d").Op(":=").Id("prev").Index(jen.Id("j")),
+					jen.If(jen.Id("in").Index(jen.Id("i")).Op("!=").Id("target").Index(jen.Id("j"))).Block(
+						jen.Id("d").Op("++"),
+					),
+					jen.If(jen.Id("prev").Index(jen.Id("j").Op("+").Lit(1)).Op("+").Lit(1).Op("<").Id("d")).Block(
+						jen.Id("d").Op("=").Id("prev").Index(jen.Id("j").Op("+").Lit(1)).Op("+").Lit(1),
+					),
+					jen.If(jen.Id("cur").Index(jen.Id("j")).Op("+").Lit(1).Op("<").Id("d")).Block(
+						jen.Id("d").Op("=").Id("cur").Index(jen.Id("j")).Op("+").Lit(1),
+					),
+					jen.Id("cur").Index(jen.Id("j").Op("+").Lit(1)).Op("=").Id("d"),
+				),
+				jen.List(jen.Id("prev"), jen.Id("cur")).Op("=").List(jen.Id("cur"), jen.Id("prev")),
+			),
+			jen.Line(),
+			jen.Id("d").Op(":=").Id("prev").Index(jen.Len(jen.Id("target"))),
+			jen.Switch().Block(
+				jen.Case(jen.Id("d").Op(">").Len(jen.Id("target")).Op("/").Lit(3)),
+				jen.Case(jen.Id("best").Op("<").Lit(0).Op("||").Id("d").Op("<").Id("best")).Block(
+					jen.List(jen.Id("best"), jen.Id("ret")).Op("=").List(jen.Id("d"), jen.Index().String().Values(jen.Id("name"))),
+				),
+				jen.Case(jen.Id("d").Op("==").Id("best")).Block(
+					jen.Id("ret").Op("=").Append(jen.Id("ret"), jen.Id("name")),
+				),
+			),
+		),
+		jen.Return(jen.Id("ret")),
+	)
+}
@@ -0,0 +1,128 @@
+package enumerator
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// fuzzParseFuncName returns the name of the generated fuzz target for Parse<Type>().
+func fuzzParseFuncName(tn *types.TypeName) string {
+	return "Fuzz" + ident.Exported(parseFuncName(tn))
+}
+
+// fuzzUnmarshalJsonFuncName returns the name of the generated fuzz target for UnmarshalJSON().
+func fuzzUnmarshalJsonFuncName(tn *types.TypeName) string {
+	return "FuzzUnmarshalJSON" + ident.Exported(tn.Name())
+}
+
+// fuzzSeeds returns the names accepted by the parser of cs, followed by malformed variations of them.
+func fuzzSeeds(cs []*enumConstant) []string {
+	var ret []string
+	for _, c := range cs {
+		ret = append(ret, c.text)
+		ret = append(ret, c.aliases...)
+	}
+
+	first := cs[0].text
+	ret = append(ret,
+		"",
+		" ",
+		"|",
+		"\x00",
+		"\xff",
+		first+" ",
+		" "+first,
+		first+"|",
+		"|"+first,
+		strings.ToLower(first),
+		strings.ToUpper(first),
+		first[:len(first)-1],
+		first+first,
+	)
+
+	return uniqueStrings(ret)
+}
+
+// fuzzJsonSeeds returns seeds quoted as JSON strings, without escaping them,
+// followed by JSON values that are not strings.
+func fuzzJsonSeeds(seeds []string) []string {
+	var ret []string
+	for _, seed := range seeds {
+		ret = append(ret, `"`+seed+`"`)
+	}
+	return uniqueStrings(append(ret, ``, `"`, `null`, `0`, `{}`, `[]`, `"\u0000"`))
+}
+
+// uniqueStrings returns ss without duplicates, keeping the first occurrence of each string.
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]bool, len(ss))
+	ret := ss[:0]
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// generateFuzzTargets generates fuzz targets for Parse<Type>() and UnmarshalJSON(), seeded with every name of cs.
+// Both check that any value that is parsed successfully is Defined(), and survives a round trip.
+func generateFuzzTargets(f *jen.File, tn *types.TypeName, cs []*enumConstant) {
+	seeds := fuzzSeeds(cs)
+
+	f.Line()
+	f.Func().Id(fuzzParseFuncName(tn)).Params(jen.Id("f").Op("*").Qual("testing", "F")).BlockFunc(func(g *jen.Group) {
+		for _, seed := range seeds {
+			g.Id("f").Dot("Add").Call(jen.Lit(seed))
+		}
+
+		g.Line()
+		g.Id("f").Dot("Fuzz").Call(jen.Func().Params(jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("s").String()).Block(
+			jen.List(jen.Id("x"), jen.Err()).Op(":=").Id(parseFuncName(tn)).Call(jen.Id("s")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(),
+			),
+			jen.Line(),
+			jen.If(jen.Op("!").Id("x").Dot("Defined").Call()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit(parseFuncName(tn)+"(%q) = %v, which is not defined"), jen.Id("s"), jen.Id("x")),
+			),
+			jen.Line(),
+			jen.If(jen.List(jen.Id("y"), jen.Err()).Op(":=").Id(parseFuncName(tn)).Call(jen.Id("x").Dot("String").Call()), jen.Err().Op("!=").Nil().Op("||").Id("y").Op("!=").Id("x")).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit(parseFuncName(tn)+"(%q) = %v, %v, want %v"), jen.Id("x").Dot("String").Call(), jen.Id("y"), jen.Err(), jen.Id("x")),
+			),
+		))
+	})
+
+	f.Line()
+	f.Func().Id(fuzzUnmarshalJsonFuncName(tn)).Params(jen.Id("f").Op("*").Qual("testing", "F")).BlockFunc(func(g *jen.Group) {
+		for _, seed := range fuzzJsonSeeds(seeds) {
+			g.Id("f").Dot("Add").Call(jen.Index().Byte().Parens(jen.Lit(seed)))
+		}
+
+		g.Line()
+		g.Id("f").Dot("Fuzz").Call(jen.Func().Params(jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("b").Index().Byte()).Block(
+			jen.Var().Id("x").Id(tn.Name()),
+			jen.If(jen.Err().Op(":=").Id("x").Dot("UnmarshalJSON").Call(jen.Id("b")), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(),
+			),
+			jen.Line(),
+			jen.If(jen.Op("!").Id("x").Dot("Defined").Call()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("UnmarshalJSON(%q) = %v, which is not defined"), jen.Id("b"), jen.Id("x")),
+			),
+			jen.Line(),
+			jen.List(jen.Id("c"), jen.Err()).Op(":=").Id("x").Dot("MarshalJSON").Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Fatalf").Call(jen.Lit("MarshalJSON() failed for %v: %v"), jen.Id("x"), jen.Err()),
+			),
+			jen.Line(),
+			jen.Var().Id("y").Id(tn.Name()),
+			jen.If(jen.Err().Op(":=").Id("y").Dot("UnmarshalJSON").Call(jen.Id("c")), jen.Err().Op("!=").Nil().Op("||").Id("y").Op("!=").Id("x")).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("UnmarshalJSON(%q) = %v, %v, want %v"), jen.Id("c"), jen.Id("y"), jen.Err(), jen.Id("x")),
+			),
+		))
+	})
+}
@@ -0,0 +1,489 @@
+package enumerator
+
+import (
+	"go/constant"
+	"go/types"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// newEnumFile creates the file that the generated code for package pkgName is written to.
+// Each line of header becomes a line of the header comment.
+func newEnumFile(pkgName string, header []string) *jen.File {
+	f := jen.NewFile(pkgName)
+	// jen only knows the names of the packages in the standard library before Go 1.23,
+	// and would import iter with an alias.
+	f.ImportName("iter", "iter")
+	for _, line := range header {
+		f.HeaderComment(line)
+	}
+	return f
+}
+
+// generateEnumCode generates the code to turn tn into an enum.
+// opts.receiver, opts.flags and opts.methods must already be resolved by the caller.
+func generateEnumCode(f *jen.File, tn *types.TypeName, cs []*enumConstant, kind constant.Kind, opts enumOptions) {
+	receiver, flags := opts.receiver, *opts.flags
+	tokenVarName := safeIndent("token", receiver)
+	stringVarName := safeIndent("str", receiver, tokenVarName)
+	scanStateVarName := safeIndent("scanState", receiver, tokenVarName, stringVarName)
+	verbVarName := safeIndent("verb", receiver, tokenVarName, stringVarName, scanStateVarName)
+	xVarName := safeIndent("x", receiver, tokenVarName, stringVarName, scanStateVarName, verbVarName)
+	yVarName := safeIndent("y", receiver, tokenVarName, stringVarName, scanStateVarName, verbVarName, xVarName)
+
+	gen := opts.methods
+	table := useNameTable(opts, kind)
+
+	// If the type declares its own String(), Bytes(), MarshalText() and MarshalJSON() return what it returns.
+	customString := opts.existing[methodString]
+	if table && (gen[methodString] || !customString && (gen[methodBytes] || gen[methodMarshalJSON])) {
+		f.Line()
+		generateNameTable(f, receiver, tn, newNameTable(cs))
+	}
+
+	if gen[methodString] {
+		f.Line()
+		switch {
+		case flags:
+			generateFlagsStringMethod(f, receiver, tn, cs)
+		case table:
+			generateTableStringMethod(f, receiver, tn)
+		default:
+			generateStringMethod(f, receiver, kind, tn, cs)
+		}
+	}
+
+	if gen[methodBytes] {
+		f.Line()
+		switch {
+		case customString:
+			generateStringBytesMethod(f, receiver, tn)
+		case flags:
+			generateFlagsBytesMethod(f, receiver, tn, cs, xVarName, yVarName)
+		case table:
+			generateTableBytesMethod(f, receiver, tn)
+		default:
+			generateBytesMethod(f, receiver, kind, tn, cs)
+		}
+	}
+
+	if gen[methodDefined] {
+		f.Line()
+		if flags {
+			generateFlagsDefinedMethod(f, receiver, tn, cs)
+		} else {
+			generateDefinedMethod(f, receiver, tn, cs, kind)
+		}
+	}
+
+	var hash *perfectHash
+	if usePerfectHash(cs, opts) && (gen[methodParse] || gen[methodScan] || gen[methodUnmarshalJSON] || gen[methodUnmarshalText]) {
+		hash, _ = newPerfectHash(cs)
+	}
+
+	if hash != nil {
+		f.Line()
+		generatePerfectHashTable(f, tn, kind, hash)
+	}
+
+	if gen[methodParse] {
+		f.Line()
+		generateParseErrors(f, tn, cs, flags, opts.closestNames)
+
+		f.Line()
+		switch {
+		case flags:
+			generateFlagsParseFunction(f, tn, stringVarName, xVarName, cs, opts.ignoreCase)
+		case hash != nil:
+			generatePerfectHashParseFunction(f, tn, kind, stringVarName)
+		default:
+			generateParseFunction(f, tn, "", kind, stringVarName, cs, opts.ignoreCase)
+		}
+	}
+
+	if gen[methodMustParse] {
+		f.Line()
+		generateMustParseFunction(f, tn, "", stringVarName, xVarName)
+	}
+
+	if gen[methodScan] {
+		f.Line()
+		generateScanMethod(f, tn, receiver, scanStateVarName, verbVarName, tokenVarName, xVarName, hash != nil, namesContainSpace(cs))
+	}
+
+	if gen[methodFlags] {
+		f.Line()
+		generateFlagsMethods(f, receiver, tn, safeIndent("flags", receiver))
+	}
+
+	if gen[methodNext] {
+		f.Line()
+		generateNextMethod(f, tn, receiver, cs, kind)
+	}
+
+	if gen[methodValues] {
+		f.Line()
+		generateValuesFunctions(f, tn, "", cs)
+
+		if opts.iterators {
+			f.Line()
+			generateIteratorFunctions(f, tn, "", cs)
+		}
+	}
+
+	if gen[methodCheck] {
+		f.Line()
+		generateCompileCheckFunction(f, tn, "", xVarName, cs, kind)
+	}
+
+	if gen[methodMarshalJSON] {
+		f.Line()
+		switch {
+		case customString:
+			generateStringJsonMarshal(f, receiver, tn)
+		case table:
+			generateTableJsonMarshal(f, receiver, tn)
+		default:
+			generateJsonMarshal(f, receiver, tn, kind, xVarName, yVarName)
+		}
+	}
+
+	if gen[methodUnmarshalJSON] {
+		f.Line()
+		generateJsonUnmarshal(f, receiver, tn, xVarName, yVarName, stringVarName, hash != nil)
+	}
+
+	if gen[methodMarshalText] {
+		f.Line()
+		generateTextMarshal(f, receiver, tn)
+	}
+
+	if gen[methodUnmarshalText] {
+		f.Line()
+		generateTextUnmarshal(f, receiver, tn, xVarName, yVarName, hash != nil)
+	}
+
+	if gen[methodValue] {
+		f.Line()
+		generateSqlValueMethod(f, receiver, tn, kind, opts.sql)
+	}
+
+	if gen[methodSQLScanner] {
+		f.Line()
+		generateSqlScanner(f, receiver, tn, kind, opts.sql, xVarName, yVarName)
+	}
+
+	f.Line()
+}
+
+// generateCompileCheckFunction generates the _() function that will fail to compile if the constant values have changed.
+// Numbers are checked by using the difference between each constant and its value as an array index, which must
+// be the constant 0. Floats are converted to int first, which fails unless the difference is a whole number, and the
+// real and imaginary parts of complex numbers are checked separately. Bools cannot be subtracted, so they are
+// checked with a map literal instead, which fails to compile if a key is duplicated. If importPath is not empty,
+// tn is an imported type, and its constants are qualified with it.
+func generateCompileCheckFunction(f *jen.File, tn *types.TypeName, importPath string, xVarName string, cs []*enumConstant, kind constant.Kind) *jen.Statement {
+	return f.Func().Id("_").Params().BlockFunc(func(g *jen.Group) {
+		if kind == constant.Bool {
+			g.Comment(`A "duplicate key" compiler error signifies that the constant values have changed.`)
+			g.Commentf(`Re-run the %s command to generate them again.`, toolName)
+			for _, c := range cs {
+				g.Id("_").Op("=").Map(jen.Qual(importPath, tn.Name())).Struct().Values(jen.Dict{
+					jen.Qual(importPath, c.Name()):              jen.Values(),
+					jen.Op("!").Add(constantLit(c.Const, kind)): jen.Values(),
+				})
+			}
+			return
+		}
+
+		g.Var().Id(xVarName).Index(jen.Lit(1)).Struct()
+		switch kind {
+		case constant.Float, constant.Complex:
+			// a difference that is not a whole number cannot be converted to an index
+			g.Comment(`A "constant truncated to integer", "cannot convert" or "invalid array index" compiler error`)
+			g.Comment(`signifies that the constant values have changed.`)
+		default:
+			g.Comment(`An "invalid array index" compiler error signifies that the constant values have changed.`)
+		}
+		g.Commentf(`Re-run the %s command to generate them again.`, toolName)
+		for _, c := range cs {
+			switch kind {
+			case constant.String:
+				v := constant.StringVal(c.Val())
+				g.Line()
+				g.Commentf("Begin %q", v)
+				for i, b := range []byte(v) {
+					g.Id("_").Op("=").Id(xVarName).Index(jen.LitByte(b).Op("-").Qual(importPath, c.Name()).Index(jen.Lit(i)))
+				}
+			case constant.Float:
+				g.Id("_").Op("=").Id(xVarName).Index(jen.Int().Call(jen.Qual(importPath, c.Name()).Op("-").Add(constantLit(c.Const, kind))))
+			case constant.Complex:
+				diff := jen.Qual(importPath, c.Name()).Op("-").Add(constantLit(c.Const, kind))
+				g.Id("_").Op("=").Id(xVarName).Index(jen.Int().Call(jen.Real(diff)))
+				g.Id("_").Op("=").Id(xVarName).Index(jen.Int().Call(jen.Imag(diff.Clone())))
+			default:
+				g.Id("_").Op("=").Id(xVarName).Index(jen.Qual(importPath, c.Name()).Op("-").Add(constantLit(c.Const, kind)))
+			}
+		}
+	})
+}
+
+// constantLit returns the literal of the value of c, an enum constant of kind. Floats and complex numbers
+// are formatted with the fewest digits that represent the value exactly in the size of the type of c.
+func constantLit(c *types.Const, kind constant.Kind) jen.Code {
+	bits := floatBits(c.Type())
+
+	// using jen.Op here is a bit of a hack, but it allows us to
+	// insert the value verbatim without surrounding it with a
+	// type cast (as Lit does)
+	switch kind {
+	case constant.Float:
+		v, _ := constant.Float64Val(c.Val())
+		return jen.Op(strconv.FormatFloat(v, 'g', -1, bits))
+	case constant.Complex:
+		re, _ := constant.Float64Val(constant.Real(c.Val()))
+		im, _ := constant.Float64Val(constant.Imag(c.Val()))
+		return jen.Op(strconv.FormatComplex(complex(re, im), 'g', -1, 2*bits))
+	default:
+		return jen.Op(c.Val().ExactString())
+	}
+}
+
+// floatBits returns the size in bits of the floats of t, or of the parts of its complex numbers.
+// It is 32 for float32 and complex64, and 64 otherwise.
+func floatBits(t types.Type) int {
+	if b, ok := t.Underlying().(*types.Basic); ok && (b.Kind() == types.Float32 || b.Kind() == types.Complex64) {
+		return 32
+	}
+	return 64
+}
+
+// generateNextMethod generates the Next() method for the enum.
+// Aliases are skipped so that each distinct value is only visited once.
+func generateNextMethod(f *jen.File, tn *types.TypeName, receiver string, cs []*enumConstant, kind constant.Kind) {
+	var zero interface{} = 0
+	switch kind {
+	case constant.String:
+		zero = `""`
+	case constant.Bool:
+		zero = false
+	}
+
+	f.Commentf("Next returns the next defined %s. If %s is not defined, then Next returns the first defined value.", tn.Name(), receiver)
+	f.Commentf("Next() can be used to loop through all values of an enum.")
+	f.Commentf("")
+	f.Commentf("\t%s := %s(%v)", receiver, tn.Name(), zero)
+	f.Comment("\tfor {")
+	f.Commentf("\t\tfmt.Println(%s)", receiver)
+	f.Commentf("\t\t%s = %s.Next()", receiver, receiver)
+	f.Commentf("\t\tif %s == %s(%v) {", receiver, tn.Name(), zero)
+	f.Comment("\t\t\tbreak")
+	f.Comment("\t\t}")
+	f.Comment("\t}")
+	f.Commentf("")
+	f.Commentf("The exact order that values are returned when looping should not be relied upon.")
+	f.Commentf("Use %s() to loop through the values in the order they are declared.", valuesFuncName(tn))
+	cs = uniqueConstants(cs)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Next").Params().Id(tn.Name()).Block(
+		jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+			for i, c := range cs {
+				ni := (i + 1) % len(cs)
+				g.Case(jen.Id(c.Name())).Block(jen.Return(jen.Id(cs[ni].Name())))
+			}
+			if len(cs) > 0 {
+				g.Default().Block(jen.Return(jen.Id(cs[0].Name())))
+			}
+		}),
+	)
+}
+
+// generateScanMethod generates the Scan() method for the enum.
+// If hashed is true, the token is looked up in the perfect hash table first, which does not allocate.
+// If spaces is true, some names contain spaces, so the rest of the line is scanned instead of a single word.
+func generateScanMethod(f *jen.File, tn *types.TypeName, receiver string, scanStateVarName string, verbVarName string, tokenVarName string, xVarName string, hashed bool, spaces bool) {
+	f.Commentf("Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into %s values", tn.Name())
+	split := jen.Nil()
+	if spaces {
+		f.Comment("")
+		f.Commentf("Some names of %s contain spaces, so the rest of the line is scanned, rather than a single word.", tn.Name())
+		split = jen.Func().Params(jen.Id("r").Rune()).Bool().Block(
+			jen.Return(jen.Id("r").Op("!=").LitRune('\n')),
+		)
+	}
+	f.Func().Params(jen.Id(receiver).Op("*").Id(tn.Name())).Id("Scan").Params(jen.Id(scanStateVarName).Qual("fmt", "ScanState"), jen.Id(verbVarName).Rune()).Error().BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id(tokenVarName), jen.Err()).Op(":=").Id(scanStateVarName).Dot("Token").Call(jen.True(), split)
+		g.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		if spaces {
+			g.Id(tokenVarName).Op("=").Qual("bytes", "TrimSpace").Call(jen.Id(tokenVarName))
+		}
+
+		g.Line()
+		g.Add(hashedFastPath(hashed, tn, receiver, xVarName, jen.Id(tokenVarName)))
+		g.List(jen.Id(xVarName), jen.Err()).Op(":=").Id(parseFuncName(tn)).Call(jen.String().Parens(jen.Id(tokenVarName)))
+		g.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		g.Op("*").Id(receiver).Op("=").Id(xVarName)
+		g.Return(jen.Nil())
+	})
+}
+
+// generateDefinedMethod generates the Defined() method for the enum.
+func generateDefinedMethod(f *jen.File, receiver string, tn *types.TypeName, cs []*enumConstant, kind constant.Kind) {
+	f.Commentf("Defined returns true if %s holds a defined value.", receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Defined").Params().Bool().Block(
+		jen.Switch(jen.Id(receiver)).Block(
+			jen.CaseFunc(func(g *jen.Group) {
+				for _, c := range uniqueConstants(cs) {
+					g.Add(constantLit(c.Const, kind))
+				}
+			}).Block(jen.Return(jen.True())),
+			jen.Default().Block(jen.Return(jen.False())),
+		),
+	)
+}
+
+// generateStringMethod generates the String() method for the enum.
+// When multiple constants share a value, the name of the first one declared is used.
+func generateStringMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, cs []*enumConstant) {
+	f.Commentf("String implements fmt.Stringer. If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	switch kind {
+	case constant.String:
+		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("String").Params().String().Block(
+			jen.Return(jen.String().Parens(jen.Id(receiver))),
+		)
+	default:
+		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("String").Params().String().Block(
+			jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+				for _, c := range uniqueConstants(cs) {
+					g.Case(jen.Id(c.Name())).Block(jen.Return(jen.Lit(c.text)))
+				}
+			}),
+			jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit(undefinedFormat(eType, kind)), jen.Id(receiver))),
+		)
+	}
+}
+
+// generateBytesMethod generates the Bytes() method for the enum.
+func generateBytesMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, cs []*enumConstant) {
+	f.Commentf("Bytes returns a byte-level representation of String(). If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	switch kind {
+	case constant.String:
+		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Bytes").Params().Op("[]").Byte().Block(
+			jen.Return(jen.Op("[]").Byte().Parens(jen.Id(receiver))),
+		)
+	default:
+		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Bytes").Params().Op("[]").Byte().Block(
+			jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+				for _, c := range uniqueConstants(cs) {
+					g.Case(jen.Id(c.Name())).Block(jen.Return(litBytes(c.text)))
+				}
+			}),
+			jen.Return(jen.Op("[]").Byte().Parens(jen.Qual("fmt", "Sprintf").Call(jen.Lit(undefinedFormat(eType, kind)), jen.Id(receiver)))),
+		)
+	}
+}
+
+// generateStringBytesMethod generates the Bytes() method for an enum that declares its own String() method.
+func generateStringBytesMethod(f *jen.File, receiver string, eType *types.TypeName) {
+	f.Commentf("Bytes returns a byte-level representation of String().")
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Bytes").Params().Op("[]").Byte().Block(
+		jen.Return(jen.Op("[]").Byte().Parens(jen.Id(receiver).Dot("String").Call())),
+	)
+}
+
+// undefinedFormat returns the fmt format of the string that String() returns for undefined values of eType,
+// an enum of kind. The verb must not call String(), so %v cannot be used. Complex numbers are already
+// formatted in parentheses.
+func undefinedFormat(eType *types.TypeName, kind constant.Kind) string {
+	switch kind {
+	case constant.Float:
+		return eType.Name() + "(%g)"
+	case constant.Complex:
+		return eType.Name() + "%g"
+	case constant.Bool:
+		return eType.Name() + "(%t)"
+	default:
+		return eType.Name() + "(%d)"
+	}
+}
+
+// litBytes returns a []byte literal holding the characters of s.
+func litBytes(s string) *jen.Statement {
+	return jen.Op("[]").Byte().ValuesFunc(func(g *jen.Group) {
+		for r, size := utf8.DecodeRuneInString(s); len(s) > 0 && r != utf8.RuneError; r, size = utf8.DecodeRuneInString(s) {
+			s = s[size:]
+			g.LitRune(r)
+		}
+	})
+}
+
+// generateJsonMarshal generates the MarshalJSON() method, which quotes the result of Bytes().
+// The values of string enums can contain any character, so they are encoded with encoding/json instead.
+func generateJsonMarshal(f *jen.File, receiver string, eType *types.TypeName, kind constant.Kind, xVarName, yVarName string) {
+	f.Commentf("MarshalJSON implements json.Marshaler")
+	if kind == constant.String {
+		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+			jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.String().Parens(jen.Id(receiver)))),
+		)
+		return
+	}
+
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Id(xVarName).Op(":=").Id(receiver).Dot("Bytes").Call(),
+		jen.Id(yVarName).Op(":=").Make(jen.Op("[]").Byte(), jen.Lit(0), jen.Len(jen.Id(xVarName))),
+		jen.Return(jen.Append(jen.Append(jen.Append(jen.Id(yVarName), jen.LitRune('"')), jen.Id(xVarName).Op("...")), jen.LitRune('"')), jen.Nil()),
+	)
+}
+
+// generateStringJsonMarshal generates the MarshalJSON() method for an enum that declares its own String() method.
+// String() can return any characters, so it is encoded with encoding/json.
+func generateStringJsonMarshal(f *jen.File, receiver string, eType *types.TypeName) {
+	f.Commentf("MarshalJSON implements json.Marshaler")
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id(receiver).Dot("String").Call())),
+	)
+}
+
+func generateJsonUnmarshal(f *jen.File, receiver string, eType *types.TypeName, xVarName, yVarName, stringVarName string, hashed bool) {
+	f.Commentf("UnmarshalJSON implements json.Unmarshaler")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalJSON").Params(jen.Id(xVarName).Op("[]").Byte()).Params(jen.Error()).Block(
+		jsonHashedFastPath(hashed, eType, receiver, xVarName, yVarName),
+		jen.Var().Id(stringVarName).String(),
+		jen.If(jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id(xVarName), jen.Op("&").Id(stringVarName)), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+
+		jen.Line(),
+		jen.List(jen.Id(yVarName), jen.Err()).Op(":=").Id(parseFuncName(eType)).Call(jen.Id(stringVarName)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Op("*").Id(receiver).Op("=").Id(yVarName),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func generateTextMarshal(f *jen.File, receiver string, eType *types.TypeName) {
+	f.Commentf("MarshalText implements encoding.TextMarshaler")
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalText").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Return(jen.Id(receiver).Dot("Bytes").Call(), jen.Nil()),
+	)
+}
+
+func generateTextUnmarshal(f *jen.File, receiver string, eType *types.TypeName, xVarName, yVarName string, hashed bool) {
+	f.Commentf("UnmarshalText implements encoding.TextUnmarshaler")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalText").Params(jen.Id(xVarName).Op("[]").Byte()).Params(jen.Error()).Block(
+		hashedFastPath(hashed, eType, receiver, yVarName, jen.Id(xVarName)),
+		jen.List(jen.Id(yVarName), jen.Err()).Op(":=").Id(parseFuncName(eType)).Call(jen.String().Parens(jen.Id(xVarName))),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Op("*").Id(receiver).Op("=").Id(yVarName),
+		jen.Return(jen.Nil()),
+	)
+}
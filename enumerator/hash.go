@@ -0,0 +1,292 @@
+package enumerator
+
+import (
+	"go/constant"
+	"go/types"
+	"sort"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// minPerfectHashNames is the minimum number of names an enum must have
+// before names are parsed with a perfect hash table instead of a switch statement.
+const minPerfectHashNames = 32
+
+// maxPerfectHashSeed is the largest seed tried for a bucket of the perfect hash table
+// before giving up and falling back to a switch statement.
+const maxPerfectHashSeed = 1 << 20
+
+const (
+	fnvOffset32 = 2166136261
+	fnvPrime32  = 16777619
+)
+
+// fnv1a returns the 32-bit FNV-1a hash of s.
+func fnv1a(s string) uint32 {
+	h := uint32(fnvOffset32)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= fnvPrime32
+	}
+	return h
+}
+
+// perfectHashSlot returns the slot of a name with hash h, when the bucket of h has the given seed.
+// The generated code must compute exactly the same value, see generatePerfectHashTable.
+func perfectHashSlot(h, seed, mask uint32) uint32 {
+	h ^= seed
+	h ^= h >> 16
+	h *= 0x7feb352d
+	h ^= h >> 15
+	h *= 0x846ca68b
+	h ^= h >> 16
+	return h & mask
+}
+
+// perfectHash is a perfect hash table of the names of an enum,
+// built with the hash and displace method. A name with FNV-1a hash h is found
+// in slot perfectHashSlot(h, seeds[h&mask], mask).
+type perfectHash struct {
+	mask  uint32
+	seeds []uint32
+	// keys and cs hold the name and constant in each slot.
+	// Both are empty for unused slots.
+	keys []string
+	cs   []*enumConstant
+}
+
+// usePerfectHash returns true if the names of cs should be parsed with a perfect hash table.
+func usePerfectHash(cs []*enumConstant, opts enumOptions) bool {
+	if opts.ignoreCase || *opts.flags {
+		return false
+	}
+
+	n := 0
+	for _, c := range cs {
+		n += 1 + len(c.aliases)
+	}
+	return n >= minPerfectHashNames
+}
+
+// newPerfectHash builds the perfect hash table for the names and aliases of cs.
+// If no table can be built, false is returned.
+func newPerfectHash(cs []*enumConstant) (*perfectHash, bool) {
+	type entry struct {
+		key string
+		h   uint32
+		c   *enumConstant
+	}
+
+	var entries []entry
+	for _, c := range cs {
+		for _, name := range append([]string{c.text}, c.aliases...) {
+			entries = append(entries, entry{key: name, h: fnv1a(name), c: c})
+		}
+	}
+
+	size := 1
+	for size < len(entries) {
+		size <<= 1
+	}
+
+	ret := &perfectHash{
+		mask:  uint32(size - 1),
+		seeds: make([]uint32, size),
+		keys:  make([]string, size),
+		cs:    make([]*enumConstant, size),
+	}
+
+	buckets := make([][]entry, size)
+	for _, e := range entries {
+		b := e.h & ret.mask
+		for _, other := range buckets[b] {
+			if other.h == e.h {
+				// Names with the same hash can never be told apart.
+				return nil, false
+			}
+		}
+		buckets[b] = append(buckets[b], e)
+	}
+
+	// Place the largest buckets first, while most slots are still free.
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	used := make([]bool, size)
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			break
+		}
+
+		hashes := make([]uint32, len(buckets[b]))
+		for i, e := range buckets[b] {
+			hashes[i] = e.h
+		}
+
+		seed, ok := findPerfectHashSeed(hashes, used, ret.mask)
+		if !ok {
+			return nil, false
+		}
+
+		ret.seeds[b] = seed
+		for _, e := range buckets[b] {
+			slot := perfectHashSlot(e.h, seed, ret.mask)
+			used[slot] = true
+			ret.keys[slot] = e.key
+			ret.cs[slot] = e.c
+		}
+	}
+
+	return ret, true
+}
+
+// findPerfectHashSeed finds a seed that places every one of hashes into a distinct unused slot.
+func findPerfectHashSeed(hashes []uint32, used []bool, mask uint32) (uint32, bool) {
+	for seed := uint32(0); seed < maxPerfectHashSeed; seed++ {
+		slots := make(map[uint32]bool, len(hashes))
+		for _, h := range hashes {
+			slot := perfectHashSlot(h, seed, mask)
+			if used[slot] || slots[slot] {
+				break
+			}
+			slots[slot] = true
+		}
+
+		if len(slots) == len(hashes) {
+			return seed, true
+		}
+	}
+	return 0, false
+}
+
+// perfectHashNames returns the names of the variables and functions generated for the perfect hash table of tn.
+func perfectHashNames(tn *types.TypeName) (seeds, keys, values, slot, lookup, lookupBytes string) {
+	prefix := ident.Unexported(tn.Name())
+	lookup = packageFuncName("lookup", tn)
+	return prefix + "NameSeeds", prefix + "NameKeys", prefix + "NameValues", prefix + "NameSlot", lookup, lookup + "Bytes"
+}
+
+// generatePerfectHashTable generates the perfect hash table t, along with
+// lookup<Type>(string) and lookup<Type>Bytes([]byte) functions that search it.
+// Neither function allocates.
+func generatePerfectHashTable(f *jen.File, tn *types.TypeName, kind constant.Kind, t *perfectHash) {
+	seedsName, keysName, valuesName, slotName, lookupName, lookupBytesName := perfectHashNames(tn)
+
+	f.Commentf("%s, %s and %s form a perfect hash table of every name accepted by %s.", seedsName, keysName, valuesName, parseFuncName(tn))
+	f.Commentf("A name with FNV-1a hash h is stored at index %s(h).", slotName)
+	f.Var().Defs(
+		jen.Id(seedsName).Op("=").Index(jen.Op("...")).Uint32().ValuesFunc(func(g *jen.Group) {
+			for _, seed := range t.seeds {
+				g.Lit(int(seed))
+			}
+		}),
+		jen.Id(keysName).Op("=").Index(jen.Op("...")).String().ValuesFunc(func(g *jen.Group) {
+			for _, key := range t.keys {
+				g.Lit(key)
+			}
+		}),
+		jen.Id(valuesName).Op("=").Index(jen.Op("...")).Id(tn.Name()).ValuesFunc(func(g *jen.Group) {
+			for _, c := range t.cs {
+				if c == nil {
+					g.Add(zeroValue(kind))
+				} else {
+					g.Id(c.Name())
+				}
+			}
+		}),
+	)
+
+	f.Line()
+	f.Commentf("%s returns the index in %s of a name with FNV-1a hash h.", slotName, keysName)
+	f.Func().Id(slotName).Params(jen.Id("h").Uint32()).Uint32().Block(
+		jen.Id("h").Op("^=").Id(seedsName).Index(jen.Id("h").Op("&").Lit(int(t.mask))),
+		jen.Id("h").Op("^=").Id("h").Op(">>").Lit(16),
+		jen.Id("h").Op("*=").Op("0x7feb352d"),
+		jen.Id("h").Op("^=").Id("h").Op(">>").Lit(15),
+		jen.Id("h").Op("*=").Op("0x846ca68b"),
+		jen.Id("h").Op("^=").Id("h").Op(">>").Lit(16),
+		jen.Return(jen.Id("h").Op("&").Lit(int(t.mask))),
+	)
+
+	lookup := func(name, param string, typ jen.Code, key jen.Code) {
+		f.Func().Id(name).Params(jen.Id(param).Add(typ)).Params(jen.Id(tn.Name()), jen.Bool()).Block(
+			jen.Id("h").Op(":=").Uint32().Parens(jen.Lit(fnvOffset32)),
+			jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Len(jen.Id(param)), jen.Id("i").Op("++")).Block(
+				jen.Id("h").Op("^=").Uint32().Parens(jen.Id(param).Index(jen.Id("i"))),
+				jen.Id("h").Op("*=").Lit(fnvPrime32),
+			),
+			jen.Line(),
+			jen.Id("i").Op(":=").Id(slotName).Call(jen.Id("h")),
+			jen.If(jen.Len(jen.Id(param)).Op("==").Lit(0).Op("||").Id(keysName).Index(jen.Id("i")).Op("!=").Add(key)).Block(
+				jen.Return(zeroValue(kind), jen.False()),
+			),
+			jen.Return(jen.Id(valuesName).Index(jen.Id("i")), jen.True()),
+		)
+	}
+
+	f.Line()
+	f.Commentf("%s returns the %s named s, and whether s is the name of a %s.", lookupName, tn.Name(), tn.Name())
+	lookup(lookupName, "s", jen.String(), jen.Id("s"))
+
+	f.Line()
+	f.Commentf("%s is like %s, but takes a []byte. It does not allocate.", lookupBytesName, lookupName)
+	lookup(lookupBytesName, "b", jen.Op("[]").Byte(), jen.String().Parens(jen.Id("b")))
+}
+
+// generatePerfectHashParseFunction generates the Parse<Type>() function for an enum that uses a perfect hash table.
+func generatePerfectHashParseFunction(f *jen.File, tn *types.TypeName, kind constant.Kind, stringVarName string) {
+	_, _, _, _, lookupName, _ := perfectHashNames(tn)
+	sVarName := safeIndent("s", stringVarName)
+	xVarName := safeIndent("x", sVarName)
+	f.Commentf("%s returns the %s named %s. An error is returned if %s is not the name of a %s.", parseFuncName(tn), tn.Name(), sVarName, sVarName, tn.Name())
+	f.Func().Id(parseFuncName(tn)).Params(jen.Id(sVarName).String()).Params(jen.Id(tn.Name()), jen.Error()).Block(
+		jen.If(jen.List(jen.Id(xVarName), jen.Id("ok")).Op(":=").Id(lookupName).Call(jen.Id(sVarName)), jen.Id("ok")).Block(
+			jen.Return(jen.Id(xVarName), jen.Nil()),
+		),
+		jen.Return(zeroValue(kind), newParseError(tn, jen.Id(sVarName))),
+	)
+}
+
+// hashedFastPath returns the statement that parses input with lookup<Type>Bytes, and stores
+// the result in receiver. If input is not a name, execution continues after it, so that
+// Parse<Type> can report the error. If hashed is false, nothing is generated.
+func hashedFastPath(hashed bool, tn *types.TypeName, receiver, yVarName string, input jen.Code) jen.Code {
+	if !hashed {
+		return jen.Null()
+	}
+
+	_, _, _, _, _, lookupBytesName := perfectHashNames(tn)
+	okVarName := safeIndent("ok", receiver, yVarName)
+	return jen.If(jen.List(jen.Id(yVarName), jen.Id(okVarName)).Op(":=").Id(lookupBytesName).Call(input), jen.Id(okVarName)).Block(
+		jen.Op("*").Id(receiver).Op("=").Id(yVarName),
+		jen.Return(jen.Nil()),
+	).Line()
+}
+
+// jsonHashedFastPath is like hashedFastPath, but only handles JSON strings without escape sequences.
+// Other input is left for encoding/json to decode.
+func jsonHashedFastPath(hashed bool, tn *types.TypeName, receiver, xVarName, yVarName string) jen.Code {
+	if !hashed {
+		return jen.Null()
+	}
+
+	_, _, _, _, _, lookupBytesName := perfectHashNames(tn)
+	okVarName := safeIndent("ok", receiver, xVarName, yVarName)
+	n := jen.Len(jen.Id(xVarName))
+	str := jen.Id(xVarName).Index(jen.Lit(1), n.Clone().Op("-").Lit(1))
+
+	// The raw bytes of a string with escape sequences are not its decoded value, so they must not be looked up.
+	return jen.If(n.Clone().Op(">=").Lit(2).Op("&&").Id(xVarName).Index(jen.Lit(0)).Op("==").LitRune('"').Op("&&").Id(xVarName).Index(n.Clone().Op("-").Lit(1)).Op("==").LitRune('"').Op("&&").
+		Qual("bytes", "IndexByte").Call(str.Clone(), jen.LitRune('\\')).Op("<").Lit(0)).Block(
+		jen.If(jen.List(jen.Id(yVarName), jen.Id(okVarName)).Op(":=").Id(lookupBytesName).Call(str), jen.Id(okVarName)).Block(
+			jen.Op("*").Id(receiver).Op("=").Id(yVarName),
+			jen.Return(jen.Nil()),
+		),
+	).Line()
+}
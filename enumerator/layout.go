@@ -0,0 +1,246 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// stringLayout determines how String() and Bytes() look up the names of values.
+type stringLayout string
+
+const (
+	// layoutTable looks up names in a table of concatenated names.
+	// It only applies to integer enums; other enums use layoutSwitch.
+	layoutTable stringLayout = "table"
+	// layoutSwitch looks up names with a switch statement.
+	layoutSwitch stringLayout = "switch"
+)
+
+// parseStringLayout parses the value of the --layout flag.
+func parseStringLayout(s string) (stringLayout, error) {
+	switch l := stringLayout(s); l {
+	case layoutTable, layoutSwitch:
+		return l, nil
+	default:
+		return layoutTable, fmt.Errorf("invalid layout %q: must be %q or %q", s, layoutTable, layoutSwitch)
+	}
+}
+
+// maxTableSparseness is the maximum ratio between the range of values and the number of values
+// for values to be looked up by offset. Sparser values are looked up in a map.
+const maxTableSparseness = 2
+
+// nameTable holds the information needed to generate the name table of an enum.
+type nameTable struct {
+	// names is the name of every value, concatenated.
+	names string
+	// index holds the offsets into names of each value.
+	// The name of value i is names[index[i]:index[i+1]].
+	index []int
+	// min is the smallest value. If min is nil, the table is sparse,
+	// and index is ordered by declaration. Otherwise, value v is at
+	// position v-min, and index contains an empty entry for each
+	// undefined value between the smallest and largest.
+	min, max constant.Value
+	// holes is true if there are undefined values between min and max.
+	holes bool
+	// cs are the constants in the table, without aliases.
+	cs []*enumConstant
+}
+
+// newNameTable creates the name table for cs.
+// Names must not be empty, so that they can be told apart from holes.
+func newNameTable(cs []*enumConstant) *nameTable {
+	cs = uniqueConstants(cs)
+	ret := &nameTable{min: cs[0].Val(), max: cs[0].Val(), cs: cs}
+	for _, c := range cs[1:] {
+		if constant.Compare(c.Val(), token.LSS, ret.min) {
+			ret.min = c.Val()
+		}
+		if constant.Compare(c.Val(), token.GTR, ret.max) {
+			ret.max = c.Val()
+		}
+	}
+
+	span, ok := constant.Int64Val(constant.BinaryOp(ret.max, token.SUB, ret.min))
+	if !ok || span >= int64(maxTableSparseness*len(cs)) {
+		// The values are sparse, so they are stored in declaration order.
+		ret.min, ret.max = nil, nil
+		ret.index = append(ret.index, 0)
+		for _, c := range cs {
+			ret.names += c.text
+			ret.index = append(ret.index, len(ret.names))
+		}
+		return ret
+	}
+
+	table := make([]*enumConstant, span+1)
+	for _, c := range cs {
+		offset, _ := constant.Int64Val(constant.BinaryOp(c.Val(), token.SUB, ret.min))
+		table[offset] = c
+	}
+
+	ret.index = append(ret.index, 0)
+	for _, c := range table {
+		if c == nil {
+			ret.holes = true
+		} else {
+			ret.names += c.text
+		}
+		ret.index = append(ret.index, len(ret.names))
+	}
+	return ret
+}
+
+// indexType returns the smallest unsigned integer type that can hold every offset in t.index.
+func (t *nameTable) indexType() string {
+	switch n := len(t.names); {
+	case n <= math.MaxUint8:
+		return "uint8"
+	case n <= math.MaxUint16:
+		return "uint16"
+	default:
+		return "uint32"
+	}
+}
+
+// nameTableNames returns the names of the variables and function generated for the name table of tn.
+func nameTableNames(tn *types.TypeName) (names, index, ordinals, indexOf string) {
+	prefix := ident.Unexported(tn.Name())
+	return prefix + "Names", prefix + "NameIndex", prefix + "NameOrdinals", prefix + "NameIndexOf"
+}
+
+// useNameTable returns true if String() and Bytes() of an enum should be generated with layoutTable.
+func useNameTable(opts enumOptions, kind constant.Kind) bool {
+	return opts.layout != layoutSwitch && kind == constant.Int && !*opts.flags
+}
+
+// generateNameTable generates the name table of the enum, and a function to find the position of a value in it.
+func generateNameTable(f *jen.File, receiver string, tn *types.TypeName, t *nameTable) {
+	namesName, indexName, ordinalsName, indexOfName := nameTableNames(tn)
+	iVarName := safeIndent("i", receiver)
+	okVarName := safeIndent("ok", receiver, iVarName)
+	unsigned := false
+	if b, ok := tn.Type().Underlying().(*types.Basic); ok {
+		unsigned = b.Info()&types.IsUnsigned != 0
+	}
+
+	f.Commentf("%s holds the name of every %s value.", namesName, tn.Name())
+	f.Const().Id(namesName).Op("=").Lit(t.names)
+
+	f.Line()
+	f.Commentf("%s holds the offsets of each %s value in %s.", indexName, tn.Name(), namesName)
+	f.Var().Id(indexName).Op("=").Index(jen.Op("...")).Id(t.indexType()).ValuesFunc(func(g *jen.Group) {
+		for _, i := range t.index {
+			g.Lit(i)
+		}
+	})
+
+	if t.min == nil {
+		f.Line()
+		f.Commentf("%s maps each %s value to its position in %s.", ordinalsName, tn.Name(), indexName)
+		f.Var().Id(ordinalsName).Op("=").Map(jen.Id(tn.Name())).Int().Values(jen.DictFunc(func(d jen.Dict) {
+			for i, c := range t.cs {
+				d[jen.Id(c.Name())] = jen.Lit(i)
+			}
+		}))
+	}
+
+	f.Line()
+	f.Commentf("%s returns the position of %s in %s, or -1 if !%s.Defined().", indexOfName, receiver, indexName, receiver)
+	f.Func().Id(indexOfName).Params(jen.Id(receiver).Id(tn.Name())).Int().BlockFunc(func(g *jen.Group) {
+		if t.min == nil {
+			g.If(jen.List(jen.Id(iVarName), jen.Id(okVarName)).Op(":=").Id(ordinalsName).Index(jen.Id(receiver)), jen.Id(okVarName)).Block(
+				jen.Return(jen.Id(iVarName)),
+			)
+			g.Return(jen.Lit(-1))
+			return
+		}
+
+		outOfRange := jen.Id(receiver).Op(">").Op(t.max.ExactString())
+		if !unsigned || constant.Sign(t.min) != 0 {
+			outOfRange = jen.Id(receiver).Op("<").Op(t.min.ExactString()).Op("||").Add(outOfRange)
+		}
+		g.If(outOfRange).Block(jen.Return(jen.Lit(-1)))
+
+		var i jen.Code
+		switch {
+		case constant.Sign(t.min) == 0:
+			i = jen.Int().Parens(jen.Id(receiver))
+		case unsigned:
+			i = jen.Int().Parens(jen.Id(receiver).Op("-").Op(t.min.ExactString()))
+		default:
+			i = jen.Int().Parens(jen.Id(receiver)).Op("-").Parens(jen.Op(t.min.ExactString()))
+		}
+
+		if !t.holes {
+			g.Return(i)
+			return
+		}
+
+		g.Id(iVarName).Op(":=").Add(i)
+		g.If(jen.Id(indexName).Index(jen.Id(iVarName)).Op("==").Id(indexName).Index(jen.Id(iVarName).Op("+").Lit(1))).Block(
+			jen.Return(jen.Lit(-1)),
+		)
+		g.Return(jen.Id(iVarName))
+	})
+}
+
+// generateTableStringMethod generates the String() method for an enum that uses layoutTable.
+func generateTableStringMethod(f *jen.File, receiver string, tn *types.TypeName) {
+	namesName, indexName, _, indexOfName := nameTableNames(tn)
+	iVarName := safeIndent("i", receiver)
+	f.Commentf("String implements fmt.Stringer. If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("String").Params().String().Block(
+		jen.If(jen.Id(iVarName).Op(":=").Id(indexOfName).Call(jen.Id(receiver)), jen.Id(iVarName).Op(">=").Lit(0)).Block(
+			jen.Return(jen.Id(namesName).Index(
+				jen.Id(indexName).Index(jen.Id(iVarName)),
+				jen.Id(indexName).Index(jen.Id(iVarName).Op("+").Lit(1)),
+			)),
+		),
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit(fmt.Sprintf("%s(%%d)", tn.Name())), jen.Id(receiver))),
+	)
+}
+
+// generateTableBytesMethod generates the Bytes() method for an enum that uses layoutTable.
+// The name is copied out of the table with a single allocation, so that callers can modify the returned slice.
+func generateTableBytesMethod(f *jen.File, receiver string, tn *types.TypeName) {
+	namesName, indexName, _, indexOfName := nameTableNames(tn)
+	iVarName := safeIndent("i", receiver)
+	f.Commentf("Bytes returns a byte-level representation of String(). If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Bytes").Params().Op("[]").Byte().Block(
+		jen.If(jen.Id(iVarName).Op(":=").Id(indexOfName).Call(jen.Id(receiver)), jen.Id(iVarName).Op(">=").Lit(0)).Block(
+			jen.Return(jen.Append(jen.Op("[]").Byte().Parens(jen.Nil()), jen.Id(namesName).Index(
+				jen.Id(indexName).Index(jen.Id(iVarName)),
+				jen.Id(indexName).Index(jen.Id(iVarName).Op("+").Lit(1)),
+			).Op("..."))),
+		),
+		jen.Return(jen.Op("[]").Byte().Parens(jen.Qual("fmt", "Sprintf").Call(jen.Lit(fmt.Sprintf("%s(%%d)", tn.Name())), jen.Id(receiver)))),
+	)
+}
+
+// generateTableJsonMarshal generates the MarshalJSON() method for an enum that uses layoutTable.
+// Names never contain characters that JSON requires to be escaped (see validateDirectiveName),
+// so they are quoted into a buffer of the right size, with a single allocation.
+func generateTableJsonMarshal(f *jen.File, receiver string, tn *types.TypeName) {
+	namesName, indexName, _, indexOfName := nameTableNames(tn)
+	iVarName := safeIndent("i", receiver)
+	nameVarName := safeIndent("name", receiver, iVarName)
+	f.Commentf("MarshalJSON implements json.Marshaler")
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.If(jen.Id(iVarName).Op(":=").Id(indexOfName).Call(jen.Id(receiver)), jen.Id(iVarName).Op(">=").Lit(0)).Block(
+			jen.Id(nameVarName).Op(":=").Id(namesName).Index(
+				jen.Id(indexName).Index(jen.Id(iVarName)),
+				jen.Id(indexName).Index(jen.Id(iVarName).Op("+").Lit(1)),
+			),
+			jen.Return(jen.Append(jen.Append(jen.Append(jen.Make(jen.Op("[]").Byte(), jen.Lit(0), jen.Len(jen.Id(nameVarName)).Op("+").Lit(2)), jen.LitRune('"')), jen.Id(nameVarName).Op("...")), jen.LitRune('"')), jen.Nil()),
+		),
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id(receiver).Dot("String").Call())),
+	)
+}
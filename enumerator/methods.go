@@ -0,0 +1,388 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+)
+
+// method is a method, or a group of related declarations, that can be generated for an enum.
+// Methods are selected with Options.Methods and Options.Skip.
+type method string
+
+const (
+	methodString    method = "String"
+	methodBytes     method = "Bytes"
+	methodDefined   method = "Defined"
+	methodParse     method = "Parse"
+	methodMustParse method = "MustParse"
+	methodScan      method = "Scan"
+	// methodFlags is the Has(), Set(), Clear() and Toggle() methods of bit flags.
+	methodFlags method = "Flags"
+	methodNext  method = "Next"
+	// methodValues is the <Type>Values(), <Type>Names() and iterator functions, and the <Type>Len constant.
+	methodValues method = "Values"
+	// methodCheck is the function that fails to compile when the constant values change.
+	methodCheck         method = "Check"
+	methodMarshalJSON   method = "MarshalJSON"
+	methodUnmarshalJSON method = "UnmarshalJSON"
+	methodMarshalText   method = "MarshalText"
+	methodUnmarshalText method = "UnmarshalText"
+	methodValue         method = "Value"
+	methodSQLScanner    method = "SQLScanner"
+)
+
+// allMethods lists every method in the order it is generated.
+var allMethods = []method{
+	methodString, methodBytes, methodDefined, methodParse, methodMustParse, methodScan, methodFlags, methodNext,
+	methodValues, methodCheck, methodMarshalJSON, methodUnmarshalJSON, methodMarshalText, methodUnmarshalText,
+	methodValue, methodSQLScanner,
+}
+
+// methodGroups are names accepted by --methods and --skip that stand for several methods.
+var methodGroups = map[string][]method{
+	"json": {methodMarshalJSON, methodUnmarshalJSON},
+	"text": {methodMarshalText, methodUnmarshalText},
+	"sql":  {methodValue, methodSQLScanner},
+}
+
+// methodSet is a set of methods.
+type methodSet map[method]bool
+
+// parseMethodSet parses a list of method and group names. Names are matched case-insensitively.
+func parseMethodSet(names []string) (methodSet, error) {
+	ret := make(methodSet)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if ms, ok := methodGroups[strings.ToLower(name)]; ok {
+			for _, m := range ms {
+				ret[m] = true
+			}
+			continue
+		}
+
+		found := false
+		for _, m := range allMethods {
+			if strings.EqualFold(name, string(m)) {
+				ret[m] = true
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("invalid method %q: must be one of %s, json, text or sql", name, strings.Join(MethodNames(), ", "))
+		}
+	}
+	return ret, nil
+}
+
+// methodDecl is a declaration added to the package when a method is generated.
+type methodDecl struct {
+	// name is the name of the declaration.
+	name string
+	// onType is true if the declaration is a method of the enum type,
+	// and false if it is declared in the package scope.
+	onType bool
+	// sig is the signature of the declaration, as formatted by signatureString.
+	// It is empty if the declaration is not a function.
+	sig string
+}
+
+// methodDecls returns the declarations added to the package of tn when m is generated.
+// The first declaration is the one that the rest of the generated code calls.
+func methodDecls(m method, tn *types.TypeName) []methodDecl {
+	t := tn.Name()
+	switch m {
+	case methodString:
+		return []methodDecl{{"String", true, "func() string"}}
+	case methodBytes:
+		return []methodDecl{{"Bytes", true, "func() []byte"}}
+	case methodDefined:
+		return []methodDecl{{"Defined", true, "func() bool"}}
+	case methodParse:
+		return []methodDecl{{parseFuncName(tn), false, "func(string) (" + t + ", error)"}, {errVarName(tn), false, ""}, {errTypeName(tn), false, ""}, {closestNamesFuncName(tn), false, ""}}
+	case methodMustParse:
+		return []methodDecl{{mustParseFuncName(tn), false, "func(string) " + t}}
+	case methodScan:
+		return []methodDecl{{"Scan", true, "func(fmt.ScanState, rune) error"}}
+	case methodFlags:
+		return []methodDecl{{"Has", true, "func(" + t + ") bool"}, {"Set", true, "func(" + t + ") " + t}, {"Clear", true, "func(" + t + ") " + t}, {"Toggle", true, "func(" + t + ") " + t}}
+	case methodNext:
+		return []methodDecl{{"Next", true, "func() " + t}}
+	case methodValues:
+		return []methodDecl{{valuesFuncName(tn), false, "func() []" + t}, {lenConstName(tn), false, ""}, {namesFuncName(tn), false, "func() []string"}, {allFuncName(tn), false, "func() iter.Seq[" + t + "]"}, {allIndexedFuncName(tn), false, "func() iter.Seq2[int, " + t + "]"}}
+	case methodMarshalJSON, methodMarshalText:
+		return []methodDecl{{string(m), true, "func() ([]byte, error)"}}
+	case methodUnmarshalJSON, methodUnmarshalText:
+		return []methodDecl{{string(m), true, "func([]byte) error"}}
+	case methodValue:
+		return []methodDecl{{"Value", true, "func() (driver.Value, error)"}}
+	case methodSQLScanner:
+		return []methodDecl{{"SQLScanner", true, "func() sql.Scanner"}}
+	default:
+		return nil
+	}
+}
+
+// signatureString formats sig without parameter names, such as "func(string) (Kind, error)".
+// Types declared in pkg are not qualified, and other types are qualified with the name of their package.
+func signatureString(sig *types.Signature, pkg *types.Package) string {
+	qualifier := func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+
+	tuple := func(t *types.Tuple) []string {
+		var ret []string
+		for i := 0; i < t.Len(); i++ {
+			ret = append(ret, types.TypeString(t.At(i).Type(), qualifier))
+		}
+		return ret
+	}
+
+	ret := "func(" + strings.Join(tuple(sig.Params()), ", ") + ")"
+	switch results := tuple(sig.Results()); len(results) {
+	case 0:
+		return ret
+	case 1:
+		return ret + " " + results[0]
+	default:
+		return ret + " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+// methodRequires returns the methods that the code generated for m calls.
+func methodRequires(m method, kind constant.Kind, opts enumOptions) []method {
+	switch m {
+	case methodString:
+		if *opts.flags {
+			return []method{methodBytes}
+		}
+	case methodBytes:
+		if opts.existing[methodString] {
+			return []method{methodString}
+		}
+	case methodMustParse, methodScan, methodUnmarshalJSON, methodUnmarshalText:
+		return []method{methodParse}
+	case methodMarshalJSON:
+		if useNameTable(opts, kind) || opts.existing[methodString] {
+			return []method{methodString}
+		}
+		return []method{methodBytes}
+	case methodMarshalText:
+		return []method{methodBytes}
+	case methodValue:
+		if opts.sql == sqlName && kind != constant.String {
+			return []method{methodDefined, methodString}
+		}
+		return []method{methodDefined}
+	case methodSQLScanner:
+		if opts.sql == sqlName && kind != constant.String {
+			return []method{methodDefined, methodUnmarshalText}
+		}
+		return []method{methodDefined}
+	}
+	return nil
+}
+
+// companionMethods are the methods that are generated for imported types.
+// Since methods cannot be declared on them, they are generated as functions instead.
+var companionMethods = methodSet{
+	methodString: true, methodDefined: true, methodParse: true, methodMustParse: true,
+	methodNext: true, methodValues: true, methodCheck: true,
+}
+
+// testRequires are the methods that the generated tests and fuzz targets call.
+var testRequires = []method{methodString, methodDefined, methodParse, methodScan, methodNext, methodValues, methodMarshalJSON, methodUnmarshalJSON}
+
+// selectMethods returns the methods to generate for tn in the package target, based on opts.methods and opts.skip,
+// and the methods that tn already declares.
+//
+// Methods that tn, or the package of tn, already declares outside opts.output are not generated.
+// If such a method was selected explicitly in opts.methods, an error is returned instead.
+// An error is also returned if a generated method calls a method that is neither generated nor declared.
+//
+// The functions generated for an imported type can never be declared already, since the type
+// cannot refer to them. If target declares one of their names, an error is returned.
+func selectMethods(fset *token.FileSet, target *types.Package, tn *types.TypeName, kind constant.Kind, opts enumOptions) (methodSet, methodSet, error) {
+	applies := func(m method) bool {
+		if opts.importPath != "" && !companionMethods[m] {
+			return false
+		}
+
+		switch m {
+		case methodFlags:
+			return *opts.flags
+		case methodValue, methodSQLScanner:
+			return opts.sql != sqlNone
+		default:
+			return true
+		}
+	}
+
+	existing := make(methodSet)
+	ret := make(methodSet)
+	for _, m := range allMethods {
+		if !applies(m) {
+			if opts.methods[m] {
+				return nil, nil, fmt.Errorf("type %q: %s cannot be generated: %s", tn.Name(), m, methodUnavailableReason(m, opts))
+			}
+			continue
+		}
+
+		if opts.importPath == "" {
+			pos, ok, err := findExistingDecl(fset, tn, m, opts.output)
+			if err != nil {
+				return nil, nil, err
+			}
+			if ok {
+				if opts.methods[m] {
+					return nil, nil, fmt.Errorf("%s: type %q already declares %s, so it cannot be generated", pos, tn.Name(), m)
+				}
+				existing[m] = true
+				continue
+			}
+		}
+
+		if (opts.methods == nil || opts.methods[m]) && !opts.skip[m] {
+			ret[m] = true
+		}
+	}
+
+	if opts.importPath != "" {
+		for _, m := range allMethods {
+			if !ret[m] {
+				continue
+			}
+
+			if name, pos, ok := findCompanionConflict(fset, target, tn, m, opts.output); ok {
+				return nil, nil, fmt.Errorf("%s: %s is already declared, so %s cannot be generated for type %s.%s", pos, name, m, opts.importPath, tn.Name())
+			}
+		}
+	}
+
+	opts.existing = existing
+	requires := func(by string, ms []method) error {
+		for _, r := range ms {
+			if !ret[r] && !existing[r] {
+				return fmt.Errorf("type %q: %s requires %s, which is not generated", tn.Name(), by, r)
+			}
+		}
+		return nil
+	}
+
+	for _, m := range allMethods {
+		if !ret[m] {
+			continue
+		}
+
+		err := requires(string(m), methodRequires(m, kind, opts))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if opts.tests || opts.fuzz {
+		err := requires("the generated tests", testRequires)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return ret, existing, nil
+}
+
+// methodUnavailableReason returns why m does not apply to an enum with opts.
+func methodUnavailableReason(m method, opts enumOptions) string {
+	if opts.importPath != "" && !companionMethods[m] {
+		return "it must be a method, and methods cannot be declared on imported types"
+	}
+	if m == methodFlags {
+		return "the enum is not a set of bit flags"
+	}
+	return "database/sql support is not enabled"
+}
+
+// findExistingDecl returns the position of a declaration of m that already exists for tn,
+// ignoring declarations in the file named output, which are replaced by the generated code.
+//
+// The generated code calls the first declaration of m, so it must be a function with the signature
+// that the generated one would have. If it is not, or if only the other declarations of m exist,
+// they would conflict with the generated code, so an error is returned.
+func findExistingDecl(fset *token.FileSet, tn *types.TypeName, m method, output string) (token.Position, bool, error) {
+	for i, d := range methodDecls(m, tn) {
+		var obj types.Object
+		if d.onType {
+			obj, _, _ = types.LookupFieldOrMethod(tn.Type(), true, tn.Pkg(), d.name)
+		} else {
+			obj = tn.Pkg().Scope().Lookup(d.name)
+		}
+		if obj == nil {
+			continue
+		}
+
+		pos := fset.Position(obj.Pos())
+		if isOutputFile(pos.Filename, output) {
+			continue
+		}
+
+		if i > 0 {
+			return pos, false, fmt.Errorf("%s: %s is already declared, so %s cannot be generated for type %q", pos, d.name, m, tn.Name())
+		}
+
+		fn, ok := obj.(*types.Func)
+		if !ok || signatureString(fn.Type().(*types.Signature), tn.Pkg()) != d.sig {
+			return pos, false, fmt.Errorf("%s: %s conflicts with the %s generated for type %q: it must be a %s", pos, d.name, m, tn.Name(), d.sig)
+		}
+		return pos, true, nil
+	}
+	return token.Position{}, false, nil
+}
+
+// findCompanionConflict returns the name and position of a declaration in target that has the name of a
+// function generated for m in place of a method of the imported type tn. Declarations in the file named output
+// are ignored, since they are replaced by the generated code.
+func findCompanionConflict(fset *token.FileSet, target *types.Package, tn *types.TypeName, m method, output string) (string, token.Position, bool) {
+	for _, d := range methodDecls(m, tn) {
+		name := d.name
+		if d.onType {
+			name = companionFuncName(tn, d.name)
+		}
+
+		obj := target.Scope().Lookup(name)
+		if obj == nil {
+			continue
+		}
+
+		pos := fset.Position(obj.Pos())
+		if !isOutputFile(pos.Filename, output) {
+			return name, pos, true
+		}
+	}
+	return "", token.Position{}, false
+}
+
+// isOutputFile returns true if the file named name is the output file named output.
+// An output file that does not exist yet cannot contain declarations, so false is returned.
+func isOutputFile(name, output string) bool {
+	as, err := os.Stat(name)
+	if err != nil {
+		return false
+	}
+
+	bs, err := os.Stat(output)
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(as, bs)
+}
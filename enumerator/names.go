@@ -0,0 +1,164 @@
+package enumerator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// caseTransform is a transformation applied to the case of constant names.
+type caseTransform string
+
+const (
+	// caseNone leaves names as they are.
+	caseNone caseTransform = ""
+	// caseSnake converts names to snake_case.
+	caseSnake caseTransform = "snake"
+	// caseKebab converts names to kebab-case.
+	caseKebab caseTransform = "kebab"
+	// caseLower converts names to lowercase.
+	caseLower caseTransform = "lower"
+	// caseUpper converts names to UPPERCASE.
+	caseUpper caseTransform = "upper"
+	// caseCamel converts names to camelCase.
+	caseCamel caseTransform = "camel"
+	// caseTitle converts names to Title Case.
+	caseTitle caseTransform = "title"
+)
+
+// parseCaseTransform parses the value of the --transform flag.
+func parseCaseTransform(s string) (caseTransform, error) {
+	switch t := caseTransform(s); t {
+	case caseNone, caseSnake, caseKebab, caseLower, caseUpper, caseCamel, caseTitle:
+		return t, nil
+	default:
+		return caseNone, fmt.Errorf("invalid transform %q: must be one of %s, %s, %s, %s, %s or %s", s, caseSnake, caseKebab, caseLower, caseUpper, caseCamel, caseTitle)
+	}
+}
+
+// nameTransform determines how the name of a constant
+// is transformed into its external representation.
+type nameTransform struct {
+	// trimPrefix is trimmed from the start of names.
+	trimPrefix string
+	// caseTransform is applied after trimPrefix is trimmed.
+	caseTransform caseTransform
+}
+
+// apply returns the external representation of the constant named name.
+func (t nameTransform) apply(name string) string {
+	// Names that consist only of the prefix are left untouched,
+	// since an empty name cannot be parsed.
+	if trimmed := strings.TrimPrefix(name, t.trimPrefix); trimmed != "" {
+		name = trimmed
+	}
+
+	switch t.caseTransform {
+	case caseSnake:
+		return strings.ToLower(strings.Join(splitWords(name), "_"))
+	case caseKebab:
+		return strings.ToLower(strings.Join(splitWords(name), "-"))
+	case caseLower:
+		return strings.ToLower(name)
+	case caseUpper:
+		return strings.ToUpper(name)
+	case caseCamel:
+		words := splitWords(name)
+		for i, w := range words {
+			if i == 0 {
+				words[i] = strings.ToLower(w)
+			} else {
+				words[i] = titleWord(w)
+			}
+		}
+		return strings.Join(words, "")
+	case caseTitle:
+		words := splitWords(name)
+		for i, w := range words {
+			words[i] = titleWord(w)
+		}
+		return strings.Join(words, " ")
+	default:
+		return name
+	}
+}
+
+// splitWords splits the identifier s into words. Words are separated by
+// underscores and hyphens, and by changes from lower to upper case. A run of
+// upper case letters is treated as a single word (e.g. "HTTPServer" is split
+// into "HTTP" and "Server"). Digits belong to the word before them.
+func splitWords(s string) []string {
+	var ret []string
+	rs := []rune(s)
+	start := 0
+	for i, r := range rs {
+		switch {
+		case r == '_' || r == '-':
+			if start < i {
+				ret = append(ret, string(rs[start:i]))
+			}
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				ret = append(ret, string(rs[start:i]))
+				start = i
+			}
+		}
+	}
+
+	if start < len(rs) {
+		ret = append(ret, string(rs[start:]))
+	}
+
+	return ret
+}
+
+// titleWord returns w with the first character in upper case and the rest in lower case.
+func titleWord(w string) string {
+	r, size := utf8.DecodeRuneInString(w)
+	return string(unicode.ToUpper(r)) + strings.ToLower(w[size:])
+}
+
+// namesContainSpace returns true if a name that is accepted when parsing cs contains white space.
+func namesContainSpace(cs []*enumConstant) bool {
+	for _, name := range parseNames(cs) {
+		if strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLits returns the literals that a parser matches for c: its text followed
+// by its aliases, each surrounded by quote. If ignoreCase is true, the literals are
+// in lower case, and must be matched against input returned by foldInput.
+func parseLits(c *enumConstant, ignoreCase bool, quote string) []jen.Code {
+	var ret []jen.Code
+	seen := make(map[string]bool)
+	for _, name := range append([]string{c.text}, c.aliases...) {
+		if ignoreCase {
+			name = strings.ToLower(name)
+		}
+
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		ret = append(ret, jen.Lit(quote+name+quote))
+	}
+	return ret
+}
+
+// foldInput returns the string expression s, converted to lower case if ignoreCase is true.
+func foldInput(s jen.Code, ignoreCase bool) jen.Code {
+	if !ignoreCase {
+		return s
+	}
+	return jen.Qual("strings", "ToLower").Call(s)
+}
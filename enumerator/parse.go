@@ -0,0 +1,75 @@
+package enumerator
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// parseFuncName returns the name of the generated function that parses tn values.
+func parseFuncName(tn *types.TypeName) string {
+	return packageFuncName("Parse", tn)
+}
+
+// mustParseFuncName returns the name of the generated function that parses tn values or panics.
+func mustParseFuncName(tn *types.TypeName) string {
+	return packageFuncName("MustParse", tn)
+}
+
+// packageFuncName returns the name of a generated package-level function for tn
+// that starts with prefix. The function is only exported if tn is.
+func packageFuncName(prefix string, tn *types.TypeName) string {
+	if ast.IsExported(tn.Name()) {
+		return prefix + tn.Name()
+	}
+	return ident.Unexported(prefix) + ident.Exported(tn.Name())
+}
+
+// zeroValue returns the zero value literal of an enum of kind.
+func zeroValue(kind constant.Kind) jen.Code {
+	switch kind {
+	case constant.String:
+		return jen.Lit("")
+	case constant.Bool:
+		return jen.False()
+	default:
+		return jen.Lit(0)
+	}
+}
+
+// generateParseFunction generates the Parse<Type>() function for the enum. It is the single
+// lookup shared by Scan(), UnmarshalJSON() and UnmarshalText(). The names of all constants,
+// including aliases, are accepted. If ignoreCase is true, names are matched case-insensitively.
+// If importPath is not empty, tn is an imported type, and its constants are qualified with it.
+func generateParseFunction(f *jen.File, tn *types.TypeName, importPath string, kind constant.Kind, stringVarName string, cs []*enumConstant, ignoreCase bool) {
+	sVarName := safeIndent("s", stringVarName)
+	f.Commentf("%s returns the %s named %s. An error is returned if %s is not the name of a %s.", parseFuncName(tn), tn.Name(), sVarName, sVarName, tn.Name())
+	f.Func().Id(parseFuncName(tn)).Params(jen.Id(sVarName).String()).Params(jen.Qual(importPath, tn.Name()), jen.Error()).Block(
+		jen.Switch(foldInput(jen.Id(sVarName), ignoreCase)).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Case(parseLits(c, ignoreCase, "")...).Block(
+					jen.Return(jen.Qual(importPath, c.Name()), jen.Nil()),
+				)
+			}
+			g.Default().Block(
+				jen.Return(zeroValue(kind), newParseError(tn, jen.Id(sVarName))),
+			)
+		}),
+	)
+}
+
+// generateMustParseFunction generates the MustParse<Type>() function for the enum.
+func generateMustParseFunction(f *jen.File, tn *types.TypeName, importPath string, stringVarName, xVarName string) {
+	sVarName := safeIndent("s", stringVarName, xVarName)
+	f.Commentf("%s is like %s, but panics if %s cannot be parsed.", mustParseFuncName(tn), parseFuncName(tn), sVarName)
+	f.Func().Id(mustParseFuncName(tn)).Params(jen.Id(sVarName).String()).Qual(importPath, tn.Name()).Block(
+		jen.List(jen.Id(xVarName), jen.Err()).Op(":=").Id(parseFuncName(tn)).Call(jen.Id(sVarName)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Panic(jen.Err()),
+		),
+		jen.Return(jen.Id(xVarName)),
+	)
+}
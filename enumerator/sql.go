@@ -0,0 +1,167 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// sqlMode determines how enum values are stored in a database.
+type sqlMode string
+
+const (
+	// sqlNone disables database/sql support.
+	sqlNone sqlMode = ""
+	// sqlName stores the names of values.
+	sqlName sqlMode = "name"
+	// sqlValue stores the underlying values.
+	sqlValue sqlMode = "value"
+)
+
+// parseSqlMode parses the value of the --sql flag.
+func parseSqlMode(s string) (sqlMode, error) {
+	switch m := sqlMode(s); m {
+	case sqlNone, sqlName, sqlValue:
+		return m, nil
+	default:
+		return sqlNone, fmt.Errorf("invalid sql mode %q: must be %q or %q", s, sqlName, sqlValue)
+	}
+}
+
+// sqlNativeType returns the name of the type that database/sql uses to represent values of kind.
+func sqlNativeType(kind constant.Kind) string {
+	switch kind {
+	case constant.Bool:
+		return "bool"
+	case constant.String:
+		return "string"
+	case constant.Float:
+		return "float64"
+	default:
+		return "int64"
+	}
+}
+
+// generateSqlValueMethod generates the Value() method that implements driver.Valuer for the enum.
+func generateSqlValueMethod(f *jen.File, receiver string, tn *types.TypeName, kind constant.Kind, mode sqlMode) {
+	if kind == constant.String {
+		mode = sqlValue
+	}
+
+	value := jen.Id(receiver).Dot("String").Call()
+	if mode == sqlValue {
+		value = jen.Id(sqlNativeType(kind)).Parens(jen.Id(receiver))
+		f.Commentf("Value implements driver.Valuer. The underlying value of %s is stored. An error is returned if !%s.Defined().", receiver, receiver)
+	} else {
+		f.Commentf("Value implements driver.Valuer. The name of %s is stored. An error is returned if !%s.Defined().", receiver, receiver)
+	}
+
+	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(
+		jen.If(jen.Op("!").Id(receiver).Dot("Defined").Call()).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("undefined "+tn.Name()+" value: %v"), jen.Id(receiver))),
+		),
+		jen.Return(value, jen.Nil()),
+	)
+}
+
+// generateSqlScanner generates the SQLScanner() method for the enum, along with the type it returns.
+// The enum itself cannot implement sql.Scanner, because it already implements fmt.Scanner.
+func generateSqlScanner(f *jen.File, receiver string, tn *types.TypeName, kind constant.Kind, mode sqlMode, xVarName, yVarName string) {
+	if kind == constant.String {
+		mode = sqlValue
+	}
+
+	scannerName := ident.Unexported(tn.Name()) + "SQLScanner"
+	vVarName := safeIndent("v", receiver, xVarName, yVarName)
+	native := sqlNativeType(kind)
+
+	f.Commentf("SQLScanner returns a sql.Scanner that stores the values it scans in %s.", receiver)
+	f.Commentf("%s implements fmt.Scanner, so it cannot implement sql.Scanner directly.", tn.Name())
+	f.Comment("")
+	f.Commentf("\terr := row.Scan(%s.SQLScanner())", receiver)
+	f.Func().Params(jen.Id(receiver).Op("*").Id(tn.Name())).Id("SQLScanner").Params().Qual("database/sql", "Scanner").Block(
+		jen.Return(jen.Parens(jen.Op("*").Id(scannerName)).Parens(jen.Id(receiver))),
+	)
+
+	f.Line()
+	f.Commentf("%s implements sql.Scanner for %s.", scannerName, tn.Name())
+	f.Type().Id(scannerName).Id(tn.Name())
+
+	// convert generates the code that converts v, a value of the native type, into yVarName.
+	// Integers that the underlying type of tn cannot hold are rejected, rather than
+	// wrapped around to another, possibly defined, value.
+	convert := func(v string) []jen.Code {
+		ret := []jen.Code{jen.Id(yVarName).Op("=").Id(tn.Name()).Parens(jen.Id(v))}
+		b, ok := tn.Type().Underlying().(*types.Basic)
+		if kind != constant.Int || !ok || b.Kind() == types.Int64 {
+			return ret
+		}
+
+		outOfRange := jen.Int64().Parens(jen.Id(yVarName)).Op("!=").Id(v)
+		if b.Info()&types.IsUnsigned != 0 {
+			outOfRange = jen.Id(v).Op("<").Lit(0).Op("||").Add(outOfRange)
+		}
+		return append(ret, jen.If(outOfRange).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("value %d is out of range for "+tn.Name()), jen.Id(v))),
+		))
+	}
+
+	// parse generates the code that converts x into yVarName. s is x as a string,
+	// and b is x as a []byte.
+	parse := func(s, b jen.Code) []jen.Code {
+		var fn string
+		var args []jen.Code
+		switch {
+		case mode == sqlName:
+			return []jen.Code{
+				jen.If(jen.Err().Op(":=").Id(yVarName).Dot("UnmarshalText").Call(b), jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Err()),
+				),
+			}
+		case kind == constant.String:
+			return []jen.Code{jen.Id(yVarName).Op("=").Id(tn.Name()).Parens(s)}
+		case kind == constant.Bool:
+			fn, args = "ParseBool", []jen.Code{s}
+		case kind == constant.Float:
+			fn, args = "ParseFloat", []jen.Code{s, jen.Lit(64)}
+		default:
+			fn, args = "ParseInt", []jen.Code{s, jen.Lit(10), jen.Lit(64)}
+		}
+
+		return append([]jen.Code{
+			jen.List(jen.Id(vVarName), jen.Err()).Op(":=").Qual("strconv", fn).Call(args...),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("failed to parse value %q into "+tn.Name()+": %w"), s, jen.Err())),
+			),
+		}, convert(vVarName)...)
+	}
+
+	f.Line()
+	if mode == sqlName {
+		f.Commentf("Scan implements sql.Scanner. string and []byte values are parsed as names, and %s values are converted directly.", native)
+	} else {
+		f.Commentf("Scan implements sql.Scanner. string, []byte and %s values are accepted.", native)
+	}
+	f.Func().Params(jen.Id(receiver).Op("*").Id(scannerName)).Id("Scan").Params(jen.Id(xVarName).Interface()).Error().Block(
+		jen.Var().Id(yVarName).Id(tn.Name()),
+		jen.Switch(jen.Id(xVarName).Op(":=").Id(xVarName).Assert(jen.Type())).BlockFunc(func(g *jen.Group) {
+			g.Case(jen.String()).Block(parse(jen.Id(xVarName), jen.Op("[]").Byte().Parens(jen.Id(xVarName)))...)
+			g.Case(jen.Op("[]").Byte()).Block(parse(jen.String().Parens(jen.Id(xVarName)), jen.Id(xVarName))...)
+			if kind != constant.String {
+				g.Case(jen.Id(native)).Block(convert(xVarName)...)
+			}
+			g.Default().Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot scan %T into "+tn.Name()), jen.Id(xVarName))),
+			)
+		}),
+		jen.Line(),
+		jen.If(jen.Op("!").Id(yVarName).Dot("Defined").Call()).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("undefined "+tn.Name()+" value: %v"), jen.Id(yVarName))),
+		),
+		jen.Op("*").Id(receiver).Op("=").Id(scannerName).Parens(jen.Id(yVarName)),
+		jen.Return(jen.Nil()),
+	)
+}
@@ -0,0 +1,14 @@
+// Package golden declares the enum type whose generated code is compared with weekday_enum.golden.
+package golden
+
+type Weekday int
+
+const (
+	Sunday Weekday = iota
+	Monday
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+)
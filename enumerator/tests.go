@@ -0,0 +1,237 @@
+package enumerator
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strconv"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/dave/jennifer/jen"
+)
+
+// generateEnumTests generates tests and fuzz targets for the code generated for tn into f,
+// as requested by opts.tests and opts.fuzz. opts.flags must already be resolved by the caller.
+func generateEnumTests(f *jen.File, tn *types.TypeName, cs []*enumConstant, kind constant.Kind, opts enumOptions) {
+	if opts.tests {
+		unique := uniqueConstants(cs)
+
+		f.Line()
+		generateStringScanTest(f, tn)
+
+		f.Line()
+		generateJsonRoundTripTest(f, tn)
+
+		f.Line()
+		generateDefinedTest(f, tn, undefinedValue(tn, unique, kind, *opts.flags))
+
+		f.Line()
+		generateNextTest(f, tn)
+	}
+
+	if opts.fuzz {
+		generateFuzzTargets(f, tn, cs)
+	}
+}
+
+// generateStringScanTest generates a test that checks that the String() of every value can be scanned back.
+func generateStringScanTest(f *jen.File, tn *types.TypeName) {
+	scan := jen.Var().Id("y").Id(tn.Name()).Line().List(jen.Id("_"), jen.Err()).Op(":=").Qual("fmt", "Sscan").Call(jen.Id("x").Dot("String").Call(), jen.Op("&").Id("y"))
+
+	f.Func().Id("Test" + ident.Exported(tn.Name()) + "_StringScanRoundTrip").Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("x")).Op(":=").Range().Id(valuesFuncName(tn)).Call()).Block(
+			scan,
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("scanning %q failed: %v"), jen.Id("x").Dot("String").Call(), jen.Err()),
+			).Else().If(jen.Id("y").Op("!=").Id("x")).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("scanning %q = %v, want %v"), jen.Id("x").Dot("String").Call(), jen.Id("y"), jen.Id("x")),
+			),
+		),
+	)
+}
+
+// generateJsonRoundTripTest generates a test that checks that every value can be marshaled to JSON and back.
+func generateJsonRoundTripTest(f *jen.File, tn *types.TypeName) {
+	f.Func().Id("Test" + ident.Exported(tn.Name()) + "_JSONRoundTrip").Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("x")).Op(":=").Range().Id(valuesFuncName(tn)).Call()).Block(
+			jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("x")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("json.Marshal(%v) failed: %v"), jen.Id("x"), jen.Err()),
+				jen.Continue(),
+			),
+			jen.Line(),
+			jen.Var().Id("y").Id(tn.Name()),
+			jen.If(jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("y")), jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("json.Unmarshal(%s) failed: %v"), jen.Id("b"), jen.Err()),
+			).Else().If(jen.Id("y").Op("!=").Id("x")).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("json.Unmarshal(%s) = %v, want %v"), jen.Id("b"), jen.Id("y"), jen.Id("x")),
+			),
+		),
+	)
+}
+
+// generateDefinedTest generates a test that checks that every value is Defined(), and that undefined is not.
+// If undefined is nil, only the first check is generated.
+func generateDefinedTest(f *jen.File, tn *types.TypeName, undefined jen.Code) {
+	f.Func().Id("Test" + ident.Exported(tn.Name()) + "_DefinedValues").Params(jen.Id("t").Op("*").Qual("testing", "T")).BlockFunc(func(g *jen.Group) {
+		g.For(jen.List(jen.Id("_"), jen.Id("x")).Op(":=").Range().Id(valuesFuncName(tn)).Call()).Block(
+			jen.If(jen.Op("!").Id("x").Dot("Defined").Call()).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("%v.Defined() = false, want true"), jen.Id("x")),
+			),
+		)
+
+		if undefined == nil {
+			return
+		}
+
+		g.Line()
+		g.If(jen.Id("x").Op(":=").Id(tn.Name()).Parens(undefined), jen.Id("x").Dot("Defined").Call()).Block(
+			jen.Id("t").Dot("Errorf").Call(jen.Lit("%v.Defined() = true, want false"), jen.Id("x")),
+		)
+	})
+}
+
+// generateNextTest generates a test that checks that looping with Next() visits every value exactly once.
+func generateNextTest(f *jen.File, tn *types.TypeName) {
+	f.Func().Id("Test"+ident.Exported(tn.Name())+"_NextVisitsAll").Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+		jen.Id("first").Op(":=").Id(valuesFuncName(tn)).Call().Index(jen.Lit(0)),
+		jen.Id("seen").Op(":=").Make(jen.Map(jen.Id(tn.Name())).Bool()),
+		jen.For(jen.List(jen.Id("x"), jen.Id("i")).Op(":=").List(jen.Id("first"), jen.Lit(0)), jen.Id("i").Op("<").Id(lenConstName(tn)), jen.List(jen.Id("x"), jen.Id("i")).Op("=").List(jen.Id("x").Dot("Next").Call(), jen.Id("i").Op("+").Lit(1))).Block(
+			jen.If(jen.Id("seen").Index(jen.Id("x"))).Block(
+				jen.Id("t").Dot("Fatalf").Call(jen.Lit("Next() visited %v twice"), jen.Id("x")),
+			),
+			jen.Id("seen").Index(jen.Id("x")).Op("=").True(),
+		),
+		jen.Line(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("x")).Op(":=").Range().Id(valuesFuncName(tn)).Call()).Block(
+			jen.If(jen.Op("!").Id("seen").Index(jen.Id("x"))).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("Next() did not visit %v"), jen.Id("x")),
+			),
+		),
+	)
+}
+
+// undefinedValue returns a value of tn that is not defined, or nil if every value of tn is defined.
+// cs must not contain aliases.
+func undefinedValue(tn *types.TypeName, cs []*enumConstant, kind constant.Kind, flags bool) jen.Code {
+	defined := func(v constant.Value) bool {
+		for _, c := range cs {
+			if constant.Compare(c.Val(), token.EQL, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch kind {
+	case constant.String:
+		v := "undefined"
+		for defined(constant.MakeString(v)) {
+			v += "_"
+		}
+		return jen.Lit(v)
+	case constant.Bool:
+		for _, v := range []bool{false, true} {
+			if !defined(constant.MakeBool(v)) {
+				return jen.Lit(v)
+			}
+		}
+		return nil
+	case constant.Int:
+		lo, hi, ok := intRange(tn)
+		if !ok {
+			return nil
+		}
+
+		inRange := func(v constant.Value) bool {
+			return constant.Compare(v, token.GEQ, lo) && constant.Compare(v, token.LEQ, hi)
+		}
+
+		if flags {
+			mask := constant.MakeInt64(0)
+			for _, c := range cs {
+				mask = constant.BinaryOp(mask, token.OR, c.Val())
+			}
+
+			for bit := uint(0); ; bit++ {
+				v := constant.Shift(constant.MakeInt64(1), token.SHL, bit)
+				if !inRange(v) {
+					break
+				}
+
+				if constant.Sign(constant.BinaryOp(v, token.AND, mask)) == 0 {
+					return jen.Op(v.ExactString())
+				}
+			}
+
+			if !hasZeroConstant(cs) {
+				return jen.Lit(0)
+			}
+			return nil
+		}
+
+		one := constant.MakeInt64(1)
+		for _, c := range cs {
+			for _, v := range []constant.Value{constant.BinaryOp(c.Val(), token.ADD, one), constant.BinaryOp(c.Val(), token.SUB, one)} {
+				if inRange(v) && !defined(v) {
+					return jen.Op(v.ExactString())
+				}
+			}
+		}
+		return nil
+	case constant.Float:
+		// The constants are rounded to the precision of tn, so the values one away from them can be
+		// rounded to a constant as well. The next representable values are tried after them.
+		bits := floatBits(tn.Type())
+		round := func(f float64) float64 {
+			if bits == 32 {
+				return float64(float32(f))
+			}
+			return f
+		}
+
+		values := make(map[float64]bool, len(cs))
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, c := range cs {
+			f, _ := constant.Float64Val(constant.ToFloat(c.Val()))
+			f = round(f)
+			values[f] = true
+			lo, hi = math.Min(lo, f), math.Max(hi, f)
+		}
+
+		next := func(f, dir float64) float64 {
+			if bits == 32 {
+				return float64(math.Nextafter32(float32(f), float32(dir)))
+			}
+			return math.Nextafter(f, dir)
+		}
+
+		for _, f := range []float64{hi + 1, next(hi, math.Inf(1)), lo - 1, next(lo, math.Inf(-1))} {
+			if f = round(f); !math.IsInf(f, 0) && !values[f] {
+				return jen.Op(strconv.FormatFloat(f, 'g', -1, bits))
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// intRange returns the smallest and largest values of the integer type tn.
+// The size of int and uint is assumed to be 32 bits, so the values are valid on every platform.
+func intRange(tn *types.TypeName) (lo, hi constant.Value, ok bool) {
+	b, ok := tn.Type().Underlying().(*types.Basic)
+	if !ok || b.Info()&types.IsInteger == 0 {
+		return nil, nil, false
+	}
+
+	bits := uint(types.SizesFor("gc", "386").Sizeof(b) * 8)
+	one := constant.MakeInt64(1)
+	if b.Info()&types.IsUnsigned != 0 {
+		return constant.MakeInt64(0), constant.BinaryOp(constant.Shift(one, token.SHL, bits), token.SUB, one), true
+	}
+
+	hi = constant.BinaryOp(constant.Shift(one, token.SHL, bits-1), token.SUB, one)
+	return constant.UnaryOp(token.SUB, constant.BinaryOp(hi, token.ADD, one), 0), hi, true
+}
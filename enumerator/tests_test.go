@@ -0,0 +1,77 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+// newTestEnum returns a type named name with the given underlying type,
+// and constants of it with the given values.
+func newTestEnum(name string, underlying types.Type, values ...constant.Value) (*types.TypeName, []*enumConstant) {
+	pkg := types.NewPackage("example.com/test", "test")
+	tn := types.NewTypeName(token.NoPos, pkg, name, nil)
+	types.NewNamed(tn, underlying, nil)
+
+	var cs []*enumConstant
+	for i, v := range values {
+		c := types.NewConst(token.NoPos, pkg, fmt.Sprintf("%s%d", name, i), tn.Type(), v)
+		cs = append(cs, &enumConstant{Const: c, text: c.Name()})
+	}
+	return tn, cs
+}
+
+func TestUndefinedValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		underlying types.Type
+		values     []constant.Value
+		want       string
+	}{
+		{
+			"float64",
+			types.Typ[types.Float64],
+			[]constant.Value{constant.MakeFloat64(0.5), constant.MakeFloat64(1.5)},
+			"2.5",
+		},
+		{
+			"float32 rounds the value after the largest constant to it",
+			types.Typ[types.Float32],
+			[]constant.Value{constant.MakeFloat64(1e10), constant.MakeFloat64(2e10)},
+			"2.0000002e+10",
+		},
+		{
+			"largest float64",
+			types.Typ[types.Float64],
+			[]constant.Value{constant.MakeFloat64(1e300), constant.MakeFloat64(1.7976931348623157e308)},
+			"9.999999999999999e+299",
+		},
+		{
+			"int",
+			types.Typ[types.Int],
+			[]constant.Value{constant.MakeInt64(0), constant.MakeInt64(1)},
+			"-1",
+		},
+		{
+			"every bool defined",
+			types.Typ[types.Bool],
+			[]constant.Value{constant.MakeBool(false), constant.MakeBool(true)},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tn, cs := newTestEnum("E", tt.underlying, tt.values...)
+			got := ""
+			if v := undefinedValue(tn, cs, tt.values[0].Kind(), false); v != nil {
+				got = fmt.Sprintf("%#v", v)
+			}
+
+			if got != tt.want {
+				t.Errorf("undefinedValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
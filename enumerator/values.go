@@ -0,0 +1,136 @@
+package enumerator
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"golang.org/x/tools/go/packages"
+)
+
+// valuesFuncName returns the name of the generated function that returns all tn values.
+func valuesFuncName(tn *types.TypeName) string {
+	return tn.Name() + "Values"
+}
+
+// namesFuncName returns the name of the generated function that returns the names of all tn values.
+func namesFuncName(tn *types.TypeName) string {
+	return tn.Name() + "Names"
+}
+
+// lenConstName returns the name of the generated constant that holds the number of tn values.
+func lenConstName(tn *types.TypeName) string {
+	return tn.Name() + "Len"
+}
+
+// generateValuesFunctions generates the <Type>Values() and <Type>Names() functions,
+// and the <Type>Len constant for the enum. Aliases are skipped. If importPath is not empty,
+// tn is an imported type, and its constants are qualified with it.
+func generateValuesFunctions(f *jen.File, tn *types.TypeName, importPath string, cs []*enumConstant) {
+	cs = uniqueConstants(cs)
+
+	f.Commentf("%s is the number of defined %s values.", lenConstName(tn), tn.Name())
+	f.Const().Id(lenConstName(tn)).Op("=").Lit(len(cs))
+
+	f.Line()
+	f.Commentf("%s returns all defined %s values in the order they are declared.", valuesFuncName(tn), tn.Name())
+	f.Comment("A new slice is returned on every call, so it is safe to modify.")
+	f.Func().Id(valuesFuncName(tn)).Params().Index().Qual(importPath, tn.Name()).Block(
+		jen.Return(jen.Index().Qual(importPath, tn.Name()).ValuesFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Qual(importPath, c.Name())
+			}
+		})),
+	)
+
+	f.Line()
+	f.Commentf("%s returns the names of all defined %s values in the same order as %s.", namesFuncName(tn), tn.Name(), valuesFuncName(tn))
+	f.Comment("A new slice is returned on every call, so it is safe to modify.")
+	f.Func().Id(namesFuncName(tn)).Params().Index().String().Block(
+		jen.Return(jen.Index().String().ValuesFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Lit(c.str())
+			}
+		})),
+	)
+}
+
+// allFuncName returns the name of the generated function that returns an iterator over all tn values.
+func allFuncName(tn *types.TypeName) string {
+	return tn.Name() + "All"
+}
+
+// allIndexedFuncName returns the name of the generated function that returns an
+// iterator over all tn values and their ordinals.
+func allIndexedFuncName(tn *types.TypeName) string {
+	return tn.Name() + "AllIndexed"
+}
+
+// iteratorGoVersion is the minimum Go version of a module for iterator functions to be generated.
+const iteratorGoVersion = "1.23"
+
+// supportsIterators returns true if the module of pkg
+// can use range-over-func iterators from package iter.
+func supportsIterators(pkg *packages.Package) bool {
+	if pkg.Module == nil {
+		return false
+	}
+
+	return goVersionAtLeast(pkg.Module.GoVersion, iteratorGoVersion)
+}
+
+// goVersionAtLeast returns true if the Go version v (e.g. "1.23" or "1.23.1") is at least min.
+func goVersionAtLeast(v, min string) bool {
+	var vMajor, vMinor, minMajor, minMinor int
+	if n, _ := fmt.Sscanf(v, "%d.%d", &vMajor, &vMinor); n < 2 {
+		return false
+	}
+
+	if n, _ := fmt.Sscanf(min, "%d.%d", &minMajor, &minMinor); n < 2 {
+		return false
+	}
+
+	return vMajor > minMajor || vMajor == minMajor && vMinor >= minMinor
+}
+
+// generateIteratorFunctions generates the <Type>All() and <Type>AllIndexed() functions for the enum.
+// Aliases are skipped. If importPath is not empty, tn is an imported type, and its constants are qualified with it.
+func generateIteratorFunctions(f *jen.File, tn *types.TypeName, importPath string, cs []*enumConstant) {
+	cs = uniqueConstants(cs)
+
+	yieldVarName := "yield"
+	iVarName := safeIndent("i", yieldVarName)
+	xVarName := safeIndent("x", yieldVarName, iVarName)
+	values := jen.Index(jen.Op("...")).Qual(importPath, tn.Name()).ValuesFunc(func(g *jen.Group) {
+		for _, c := range cs {
+			g.Qual(importPath, c.Name())
+		}
+	})
+
+	f.Commentf("%s returns an iterator over all defined %s values in the order they are declared.", allFuncName(tn), tn.Name())
+	f.Comment("")
+	f.Commentf("\tfor %s := range %s() {", defaultReceiverName(tn), allFuncName(tn))
+	f.Commentf("\t\tfmt.Println(%s)", defaultReceiverName(tn))
+	f.Comment("\t}")
+	f.Func().Id(allFuncName(tn)).Params().Qual("iter", "Seq").Types(jen.Qual(importPath, tn.Name())).Block(
+		jen.Return(jen.Func().Params(jen.Id(yieldVarName).Func().Params(jen.Qual(importPath, tn.Name())).Bool()).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id(xVarName)).Op(":=").Range().Add(values)).Block(
+				jen.If(jen.Op("!").Id(yieldVarName).Call(jen.Id(xVarName))).Block(
+					jen.Return(),
+				),
+			),
+		)),
+	)
+
+	f.Line()
+	f.Commentf("%s returns an iterator over all defined %s values and their ordinals in the order they are declared.", allIndexedFuncName(tn), tn.Name())
+	f.Func().Id(allIndexedFuncName(tn)).Params().Qual("iter", "Seq2").Types(jen.Int(), jen.Qual(importPath, tn.Name())).Block(
+		jen.Return(jen.Func().Params(jen.Id(yieldVarName).Func().Params(jen.Int(), jen.Qual(importPath, tn.Name())).Bool()).Block(
+			jen.For(jen.List(jen.Id(iVarName), jen.Id(xVarName)).Op(":=").Range().Add(values.Clone())).Block(
+				jen.If(jen.Op("!").Id(yieldVarName).Call(jen.Id(iVarName), jen.Id(xVarName))).Block(
+					jen.Return(),
+				),
+			),
+		)),
+	)
+}
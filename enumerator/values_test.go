@@ -0,0 +1,90 @@
+package enumerator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"1.23", true},
+		{"1.23.1", true},
+		{"1.24", true},
+		{"2.0", true},
+		{"1.22", false},
+		{"1.22.9", false},
+		{"1", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := goVersionAtLeast(tt.v, iteratorGoVersion); got != tt.want {
+			t.Errorf("goVersionAtLeast(%q, %q) = %v, want %v", tt.v, iteratorGoVersion, got, tt.want)
+		}
+	}
+}
+
+func TestGenerate_Iterators(t *testing.T) {
+	src := `package t
+
+type Kind int
+
+const (
+	KindA Kind = iota
+	KindB
+	KindDefault = KindA
+)
+`
+	use := `package t
+
+func kinds() (ret []Kind) {
+	for k := range KindAll() {
+		ret = append(ret, k)
+	}
+	for i, k := range KindAllIndexed() {
+		ret[i] = k
+	}
+	return ret
+}
+`
+
+	tests := []struct {
+		name      string
+		goVersion string
+		want      bool
+	}{
+		{"go 1.23", "1.23", true},
+		{"go 1.22", "1.22", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadTestPackage(t, map[string]string{"t.go": src})
+			pkg.Module.GoVersion = tt.goVersion
+
+			b, err := Generate(pkg, "Kind", Options{})
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			code := string(b)
+			if got := strings.Contains(code, "func KindAll() iter.Seq[Kind]") && strings.Contains(code, "func KindAllIndexed() iter.Seq2[int, Kind]"); got != tt.want {
+				t.Fatalf("Generate() declares iterators = %v, want %v:\n%s", got, tt.want, code)
+			}
+			if !tt.want {
+				return
+			}
+
+			// iter is imported without an alias.
+			if !strings.Contains(code, "\t\"iter\"\n") {
+				t.Errorf("Generate() does not import iter:\n%s", code)
+			}
+
+			checked := loadTestPackage(t, map[string]string{"t.go": src, "t_enum.go": code, "use.go": use})
+			for _, err := range checked.Errors {
+				t.Errorf("generated code cannot be ranged over: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Code generated by "go-enumerator --tests" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// String implements fmt.Stringer. If !a.Defined(), then a generated string is returned based on a's value.
+func (a Answer) String() string {
+	switch a {
+	case No:
+		return "No"
+	case Yes:
+		return "Yes"
+	}
+	return fmt.Sprintf("Answer(%t)", a)
+}
+
+// Bytes returns a byte-level representation of String(). If !a.Defined(), then a generated string is returned based on a's value.
+func (a Answer) Bytes() []byte {
+	switch a {
+	case No:
+		return []byte{'N', 'o'}
+	case Yes:
+		return []byte{'Y', 'e', 's'}
+	}
+	return []byte(fmt.Sprintf("Answer(%t)", a))
+}
+
+// Defined returns true if a holds a defined value.
+func (a Answer) Defined() bool {
+	switch a {
+	case false, true:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidAnswer is matched by the errors returned when a Answer cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidAnswer = errors.New("invalid Answer")
+
+// InvalidAnswerError is the error returned when a string is not the name of a Answer.
+// It matches ErrInvalidAnswer with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidAnswerError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidAnswerError) Error() string {
+	msg := fmt.Sprintf("invalid Answer %q: must be one of No or Yes", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidAnswerError) Suggestions() []string {
+	return answerClosestNames(e.Input, []string{"No", "Yes"})
+}
+
+// Is returns true if target is ErrInvalidAnswer.
+func (e *InvalidAnswerError) Is(target error) bool {
+	return target == ErrInvalidAnswer
+}
+
+// ParseAnswer returns the Answer named s. An error is returned if s is not the name of a Answer.
+func ParseAnswer(s string) (Answer, error) {
+	switch s {
+	case "No":
+		return No, nil
+	case "Yes":
+		return Yes, nil
+	default:
+		return false, &InvalidAnswerError{Input: s}
+	}
+}
+
+// MustParseAnswer is like ParseAnswer, but panics if s cannot be parsed.
+func MustParseAnswer(s string) Answer {
+	x, err := ParseAnswer(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Answer values
+func (a *Answer) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseAnswer(string(token))
+	if err != nil {
+		return err
+	}
+	*a = x
+	return nil
+}
+
+// Next returns the next defined Answer. If a is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	a := Answer(false)
+//	for {
+//		fmt.Println(a)
+//		a = a.Next()
+//		if a == Answer(false) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use AnswerValues() to loop through the values in the order they are declared.
+func (a Answer) Next() Answer {
+	switch a {
+	case No:
+		return Yes
+	case Yes:
+		return No
+	default:
+		return No
+	}
+}
+
+// AnswerLen is the number of defined Answer values.
+const AnswerLen = 2
+
+// AnswerValues returns all defined Answer values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func AnswerValues() []Answer {
+	return []Answer{No, Yes}
+}
+
+// AnswerNames returns the names of all defined Answer values in the same order as AnswerValues.
+// A new slice is returned on every call, so it is safe to modify.
+func AnswerNames() []string {
+	return []string{"No", "Yes"}
+}
+
+func _() {
+	// A "duplicate key" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = map[Answer]struct{}{
+		!false: {},
+		No:     {},
+	}
+	_ = map[Answer]struct{}{
+		!true: {},
+		Yes:   {},
+	}
+}
+
+// MarshalJSON implements json.Marshaler
+func (a Answer) MarshalJSON() ([]byte, error) {
+	x := a.Bytes()
+	y := make([]byte, 0, len(x))
+	return append(append(append(y, '"'), x...), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (a *Answer) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseAnswer(str)
+	if err != nil {
+		return err
+	}
+	*a = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a Answer) MarshalText() ([]byte, error) {
+	return a.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (a *Answer) UnmarshalText(x []byte) error {
+	y, err := ParseAnswer(string(x))
+	if err != nil {
+		return err
+	}
+	*a = y
+	return nil
+}
+
+// answerClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func answerClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,384 @@
+// Code generated by "go-enumerator" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// String implements fmt.Stringer. If !c.Defined(), then a generated string is returned based on c's value.
+func (c Class) String() string {
+	return string(c)
+}
+
+// Bytes returns a byte-level representation of String(). If !c.Defined(), then a generated string is returned based on c's value.
+func (c Class) Bytes() []byte {
+	return []byte(c)
+}
+
+// Defined returns true if c holds a defined value.
+func (c Class) Defined() bool {
+	switch c {
+	case "\\d", "\\D", "\\w", "\\W", "\\s", "\\S", "\\pL", "\\PL", "\\pN", "\\PN", "\\pP", "\\PP", "\\pS", "\\PS", "\\n", ".":
+		return true
+	default:
+		return false
+	}
+}
+
+// classNameSeeds, classNameKeys and classNameValues form a perfect hash table of every name accepted by ParseClass.
+// A name with FNV-1a hash h is stored at index classNameSlot(h).
+var (
+	classNameSeeds  = [...]uint32{0, 3, 0, 0, 2, 1, 2, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 5, 0, 4, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 26, 47}
+	classNameKeys   = [...]string{"ClassNotSymbol", "ClassNotNumber", "ClassNotLetter", "\\d", "\\n", "\\s", "ClassPunct", "\\pP", "\\w", "\\W", "ClassNotSpace", "\\pS", "ClassLetter", "\\PL", "\\D", "ClassNotPunct", "ClassNotDigit", "ClassNewline", "ClassSymbol", "ClassWord", "ClassNumber", "\\S", "\\PS", "\\PP", "\\pN", "ClassNotWord", "ClassAny", "\\PN", "\\pL", "ClassSpace", ".", "ClassDigit"}
+	classNameValues = [...]Class{ClassNotSymbol, ClassNotNumber, ClassNotLetter, ClassDigit, ClassNewline, ClassSpace, ClassPunct, ClassPunct, ClassWord, ClassNotWord, ClassNotSpace, ClassSymbol, ClassLetter, ClassNotLetter, ClassNotDigit, ClassNotPunct, ClassNotDigit, ClassNewline, ClassSymbol, ClassWord, ClassNumber, ClassNotSpace, ClassNotSymbol, ClassNotPunct, ClassNumber, ClassNotWord, ClassAny, ClassNotNumber, ClassLetter, ClassSpace, ClassAny, ClassDigit}
+)
+
+// classNameSlot returns the index in classNameKeys of a name with FNV-1a hash h.
+func classNameSlot(h uint32) uint32 {
+	h ^= classNameSeeds[h&31]
+	h ^= h >> 16
+	h *= 0x7feb352d
+	h ^= h >> 15
+	h *= 0x846ca68b
+	h ^= h >> 16
+	return h & 31
+}
+
+// lookupClass returns the Class named s, and whether s is the name of a Class.
+func lookupClass(s string) (Class, bool) {
+	h := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+
+	i := classNameSlot(h)
+	if len(s) == 0 || classNameKeys[i] != s {
+		return "", false
+	}
+	return classNameValues[i], true
+}
+
+// lookupClassBytes is like lookupClass, but takes a []byte. It does not allocate.
+func lookupClassBytes(b []byte) (Class, bool) {
+	h := uint32(2166136261)
+	for i := 0; i < len(b); i++ {
+		h ^= uint32(b[i])
+		h *= 16777619
+	}
+
+	i := classNameSlot(h)
+	if len(b) == 0 || classNameKeys[i] != string(b) {
+		return "", false
+	}
+	return classNameValues[i], true
+}
+
+// ErrInvalidClass is matched by the errors returned when a Class cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidClass = errors.New("invalid Class")
+
+// InvalidClassError is the error returned when a string is not the name of a Class.
+// It matches ErrInvalidClass with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidClassError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidClassError) Error() string {
+	msg := fmt.Sprintf("invalid Class %q: must be one of \\d, \\D, \\w, \\W, \\s, \\S, \\pL, \\PL, \\pN, \\PN, \\pP, \\PP, \\pS, \\PS, \\n or .", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidClassError) Suggestions() []string {
+	return classClosestNames(e.Input, []string{"ClassDigit", "\\d", "ClassNotDigit", "\\D", "ClassWord", "\\w", "ClassNotWord", "\\W", "ClassSpace", "\\s", "ClassNotSpace", "\\S", "ClassLetter", "\\pL", "ClassNotLetter", "\\PL", "ClassNumber", "\\pN", "ClassNotNumber", "\\PN", "ClassPunct", "\\pP", "ClassNotPunct", "\\PP", "ClassSymbol", "\\pS", "ClassNotSymbol", "\\PS", "ClassNewline", "\\n", "ClassAny", "."})
+}
+
+// Is returns true if target is ErrInvalidClass.
+func (e *InvalidClassError) Is(target error) bool {
+	return target == ErrInvalidClass
+}
+
+// ParseClass returns the Class named s. An error is returned if s is not the name of a Class.
+func ParseClass(s string) (Class, error) {
+	if x, ok := lookupClass(s); ok {
+		return x, nil
+	}
+	return "", &InvalidClassError{Input: s}
+}
+
+// MustParseClass is like ParseClass, but panics if s cannot be parsed.
+func MustParseClass(s string) Class {
+	x, err := ParseClass(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Class values
+func (c *Class) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	if x, ok := lookupClassBytes(token); ok {
+		*c = x
+		return nil
+	}
+
+	x, err := ParseClass(string(token))
+	if err != nil {
+		return err
+	}
+	*c = x
+	return nil
+}
+
+// Next returns the next defined Class. If c is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	c := Class("")
+//	for {
+//		fmt.Println(c)
+//		c = c.Next()
+//		if c == Class("") {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use ClassValues() to loop through the values in the order they are declared.
+func (c Class) Next() Class {
+	switch c {
+	case ClassDigit:
+		return ClassNotDigit
+	case ClassNotDigit:
+		return ClassWord
+	case ClassWord:
+		return ClassNotWord
+	case ClassNotWord:
+		return ClassSpace
+	case ClassSpace:
+		return ClassNotSpace
+	case ClassNotSpace:
+		return ClassLetter
+	case ClassLetter:
+		return ClassNotLetter
+	case ClassNotLetter:
+		return ClassNumber
+	case ClassNumber:
+		return ClassNotNumber
+	case ClassNotNumber:
+		return ClassPunct
+	case ClassPunct:
+		return ClassNotPunct
+	case ClassNotPunct:
+		return ClassSymbol
+	case ClassSymbol:
+		return ClassNotSymbol
+	case ClassNotSymbol:
+		return ClassNewline
+	case ClassNewline:
+		return ClassAny
+	case ClassAny:
+		return ClassDigit
+	default:
+		return ClassDigit
+	}
+}
+
+// ClassLen is the number of defined Class values.
+const ClassLen = 16
+
+// ClassValues returns all defined Class values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func ClassValues() []Class {
+	return []Class{ClassDigit, ClassNotDigit, ClassWord, ClassNotWord, ClassSpace, ClassNotSpace, ClassLetter, ClassNotLetter, ClassNumber, ClassNotNumber, ClassPunct, ClassNotPunct, ClassSymbol, ClassNotSymbol, ClassNewline, ClassAny}
+}
+
+// ClassNames returns the names of all defined Class values in the same order as ClassValues.
+// A new slice is returned on every call, so it is safe to modify.
+func ClassNames() []string {
+	return []string{"\\d", "\\D", "\\w", "\\W", "\\s", "\\S", "\\pL", "\\PL", "\\pN", "\\PN", "\\pP", "\\PP", "\\pS", "\\PS", "\\n", "."}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+
+	// Begin "\\d"
+	_ = x[byte(0x5c)-ClassDigit[0]]
+	_ = x[byte(0x64)-ClassDigit[1]]
+
+	// Begin "\\D"
+	_ = x[byte(0x5c)-ClassNotDigit[0]]
+	_ = x[byte(0x44)-ClassNotDigit[1]]
+
+	// Begin "\\w"
+	_ = x[byte(0x5c)-ClassWord[0]]
+	_ = x[byte(0x77)-ClassWord[1]]
+
+	// Begin "\\W"
+	_ = x[byte(0x5c)-ClassNotWord[0]]
+	_ = x[byte(0x57)-ClassNotWord[1]]
+
+	// Begin "\\s"
+	_ = x[byte(0x5c)-ClassSpace[0]]
+	_ = x[byte(0x73)-ClassSpace[1]]
+
+	// Begin "\\S"
+	_ = x[byte(0x5c)-ClassNotSpace[0]]
+	_ = x[byte(0x53)-ClassNotSpace[1]]
+
+	// Begin "\\pL"
+	_ = x[byte(0x5c)-ClassLetter[0]]
+	_ = x[byte(0x70)-ClassLetter[1]]
+	_ = x[byte(0x4c)-ClassLetter[2]]
+
+	// Begin "\\PL"
+	_ = x[byte(0x5c)-ClassNotLetter[0]]
+	_ = x[byte(0x50)-ClassNotLetter[1]]
+	_ = x[byte(0x4c)-ClassNotLetter[2]]
+
+	// Begin "\\pN"
+	_ = x[byte(0x5c)-ClassNumber[0]]
+	_ = x[byte(0x70)-ClassNumber[1]]
+	_ = x[byte(0x4e)-ClassNumber[2]]
+
+	// Begin "\\PN"
+	_ = x[byte(0x5c)-ClassNotNumber[0]]
+	_ = x[byte(0x50)-ClassNotNumber[1]]
+	_ = x[byte(0x4e)-ClassNotNumber[2]]
+
+	// Begin "\\pP"
+	_ = x[byte(0x5c)-ClassPunct[0]]
+	_ = x[byte(0x70)-ClassPunct[1]]
+	_ = x[byte(0x50)-ClassPunct[2]]
+
+	// Begin "\\PP"
+	_ = x[byte(0x5c)-ClassNotPunct[0]]
+	_ = x[byte(0x50)-ClassNotPunct[1]]
+	_ = x[byte(0x50)-ClassNotPunct[2]]
+
+	// Begin "\\pS"
+	_ = x[byte(0x5c)-ClassSymbol[0]]
+	_ = x[byte(0x70)-ClassSymbol[1]]
+	_ = x[byte(0x53)-ClassSymbol[2]]
+
+	// Begin "\\PS"
+	_ = x[byte(0x5c)-ClassNotSymbol[0]]
+	_ = x[byte(0x50)-ClassNotSymbol[1]]
+	_ = x[byte(0x53)-ClassNotSymbol[2]]
+
+	// Begin "\\n"
+	_ = x[byte(0x5c)-ClassNewline[0]]
+	_ = x[byte(0x6e)-ClassNewline[1]]
+
+	// Begin "."
+	_ = x[byte(0x2e)-ClassAny[0]]
+}
+
+// MarshalJSON implements json.Marshaler
+func (c Class) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (c *Class) UnmarshalJSON(x []byte) error {
+	if len(x) >= 2 && x[0] == '"' && x[len(x)-1] == '"' && bytes.IndexByte(x[1:len(x)-1], '\\') < 0 {
+		if y, ok := lookupClassBytes(x[1 : len(x)-1]); ok {
+			*c = y
+			return nil
+		}
+	}
+
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseClass(str)
+	if err != nil {
+		return err
+	}
+	*c = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c Class) MarshalText() ([]byte, error) {
+	return c.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *Class) UnmarshalText(x []byte) error {
+	if y, ok := lookupClassBytes(x); ok {
+		*c = y
+		return nil
+	}
+
+	y, err := ParseClass(string(x))
+	if err != nil {
+		return err
+	}
+	*c = y
+	return nil
+}
+
+// classClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func classClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
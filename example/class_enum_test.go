@@ -0,0 +1,99 @@
+package example
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestClass_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       string
+		want    Class
+		wantErr bool
+	}{
+		{
+			"value",
+			`"."`,
+			ClassAny,
+			false,
+		},
+		{
+			"escaped backslash",
+			`"\\pL"`,
+			ClassLetter,
+			false,
+		},
+		{
+			"escaped backslash that looks like an escape sequence",
+			`"\\n"`,
+			ClassNewline,
+			false,
+		},
+		{
+			"escape sequence",
+			`"\n"`,
+			"",
+			true,
+		},
+		{
+			"unicode escape",
+			`"\u005cd"`,
+			ClassDigit,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Class
+			err := json.Unmarshal([]byte(tt.x), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal(%s) error = %v, wantErr %v", tt.x, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.x, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClass_RoundTrip(t *testing.T) {
+	for _, c := range ClassValues() {
+		x, err := json.Marshal(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Class
+		if err := json.Unmarshal(x, &got); err != nil || got != c {
+			t.Errorf("json.Unmarshal(%s) = %q, %v, want %q", x, got, err, c)
+		}
+	}
+}
+
+func TestClassNames(t *testing.T) {
+	names := ClassNames()
+	for i, c := range ClassValues() {
+		if names[i] != c.String() {
+			t.Errorf("ClassNames()[%d] = %q, want %q", i, names[i], c.String())
+		}
+
+		if got, err := ParseClass(names[i]); err != nil || got != c {
+			t.Errorf("ParseClass(%q) = %q, %v, want %q", names[i], got, err, c)
+		}
+	}
+}
+
+func TestInvalidClassError(t *testing.T) {
+	_, err := ParseClass("ClassDigits")
+	if err == nil {
+		t.Fatal("ParseClass() error = nil, want error")
+	}
+
+	// The valid names are listed the way String() returns them.
+	want := `invalid Class "ClassDigits": must be one of \d, \D, \w, \W, \s, \S, \pL, \PL, \pN, \PN, \pP, \PP, \pS, \PS, \n or .`
+	if got := err.Error(); !strings.HasPrefix(got, want) {
+		t.Errorf("Error() = %q, want prefix %q", got, want)
+	}
+}
@@ -0,0 +1,305 @@
+// Code generated by "go-enumerator --sql=name" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// colorNames holds the name of every Color value.
+const colorNames = "RedGreenBlue"
+
+// colorNameIndex holds the offsets of each Color value in colorNames.
+var colorNameIndex = [...]uint8{0, 3, 8, 12}
+
+// colorNameIndexOf returns the position of c in colorNameIndex, or -1 if !c.Defined().
+func colorNameIndexOf(c Color) int {
+	if c < 0 || c > 2 {
+		return -1
+	}
+	return int(c)
+}
+
+// String implements fmt.Stringer. If !c.Defined(), then a generated string is returned based on c's value.
+func (c Color) String() string {
+	if i := colorNameIndexOf(c); i >= 0 {
+		return colorNames[colorNameIndex[i]:colorNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Color(%d)", c)
+}
+
+// Bytes returns a byte-level representation of String(). If !c.Defined(), then a generated string is returned based on c's value.
+func (c Color) Bytes() []byte {
+	if i := colorNameIndexOf(c); i >= 0 {
+		return append([]byte(nil), colorNames[colorNameIndex[i]:colorNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Color(%d)", c))
+}
+
+// Defined returns true if c holds a defined value.
+func (c Color) Defined() bool {
+	switch c {
+	case 0, 1, 2:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidColor is matched by the errors returned when a Color cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidColor = errors.New("invalid Color")
+
+// InvalidColorError is the error returned when a string is not the name of a Color.
+// It matches ErrInvalidColor with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidColorError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidColorError) Error() string {
+	msg := fmt.Sprintf("invalid Color %q: must be one of Red, Green or Blue", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidColorError) Suggestions() []string {
+	return colorClosestNames(e.Input, []string{"Red", "Green", "Blue", "Default"})
+}
+
+// Is returns true if target is ErrInvalidColor.
+func (e *InvalidColorError) Is(target error) bool {
+	return target == ErrInvalidColor
+}
+
+// ParseColor returns the Color named s. An error is returned if s is not the name of a Color.
+func ParseColor(s string) (Color, error) {
+	switch s {
+	case "Red":
+		return Red, nil
+	case "Green":
+		return Green, nil
+	case "Blue":
+		return Blue, nil
+	case "Default":
+		return Default, nil
+	default:
+		return 0, &InvalidColorError{Input: s}
+	}
+}
+
+// MustParseColor is like ParseColor, but panics if s cannot be parsed.
+func MustParseColor(s string) Color {
+	x, err := ParseColor(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Color values
+func (c *Color) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseColor(string(token))
+	if err != nil {
+		return err
+	}
+	*c = x
+	return nil
+}
+
+// Next returns the next defined Color. If c is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	c := Color(0)
+//	for {
+//		fmt.Println(c)
+//		c = c.Next()
+//		if c == Color(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use ColorValues() to loop through the values in the order they are declared.
+func (c Color) Next() Color {
+	switch c {
+	case Red:
+		return Green
+	case Green:
+		return Blue
+	case Blue:
+		return Red
+	default:
+		return Red
+	}
+}
+
+// ColorLen is the number of defined Color values.
+const ColorLen = 3
+
+// ColorValues returns all defined Color values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func ColorValues() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+// ColorNames returns the names of all defined Color values in the same order as ColorValues.
+// A new slice is returned on every call, so it is safe to modify.
+func ColorNames() []string {
+	return []string{"Red", "Green", "Blue"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[Red-0]
+	_ = x[Green-1]
+	_ = x[Blue-2]
+	_ = x[Default-0]
+}
+
+// MarshalJSON implements json.Marshaler
+func (c Color) MarshalJSON() ([]byte, error) {
+	if i := colorNameIndexOf(c); i >= 0 {
+		name := colorNames[colorNameIndex[i]:colorNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (c *Color) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseColor(str)
+	if err != nil {
+		return err
+	}
+	*c = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c Color) MarshalText() ([]byte, error) {
+	return c.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *Color) UnmarshalText(x []byte) error {
+	y, err := ParseColor(string(x))
+	if err != nil {
+		return err
+	}
+	*c = y
+	return nil
+}
+
+// Value implements driver.Valuer. The name of c is stored. An error is returned if !c.Defined().
+func (c Color) Value() (driver.Value, error) {
+	if !c.Defined() {
+		return nil, fmt.Errorf("undefined Color value: %v", c)
+	}
+	return c.String(), nil
+}
+
+// SQLScanner returns a sql.Scanner that stores the values it scans in c.
+// Color implements fmt.Scanner, so it cannot implement sql.Scanner directly.
+//
+//	err := row.Scan(c.SQLScanner())
+func (c *Color) SQLScanner() sql.Scanner {
+	return (*colorSQLScanner)(c)
+}
+
+// colorSQLScanner implements sql.Scanner for Color.
+type colorSQLScanner Color
+
+// Scan implements sql.Scanner. string and []byte values are parsed as names, and int64 values are converted directly.
+func (c *colorSQLScanner) Scan(x interface{}) error {
+	var y Color
+	switch x := x.(type) {
+	case string:
+		if err := y.UnmarshalText([]byte(x)); err != nil {
+			return err
+		}
+	case []byte:
+		if err := y.UnmarshalText(x); err != nil {
+			return err
+		}
+	case int64:
+		y = Color(x)
+		if int64(y) != x {
+			return fmt.Errorf("value %d is out of range for Color", x)
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into Color", x)
+	}
+
+	if !y.Defined() {
+		return fmt.Errorf("undefined Color value: %v", y)
+	}
+	*c = colorSQLScanner(y)
+	return nil
+}
+
+// colorClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func colorClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
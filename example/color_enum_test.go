@@ -0,0 +1,206 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestColor_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Color
+		want string
+	}{
+		{
+			"Red",
+			Red,
+			"Red",
+		},
+		{
+			"Default",
+			Default,
+			"Red",
+		},
+		{
+			"undefined",
+			-1,
+			"Color(-1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Color
+		wantErr bool
+	}{
+		{
+			"Red",
+			"Red",
+			Red,
+			false,
+		},
+		{
+			"Default",
+			"Default",
+			Red,
+			false,
+		},
+		{
+			"unknown",
+			"Purple",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Color
+			_, err := fmt.Sscan(tt.input, &got)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_UnmarshalJSON(t *testing.T) {
+	var got Color
+	if err := json.Unmarshal([]byte(`"Default"`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != Default {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, Default)
+	}
+}
+
+func ExampleColor_Next() {
+	c := Color(0)
+	for {
+		fmt.Println(c)
+		c = c.Next()
+		if c == Color(0) {
+			break
+		}
+	}
+
+	// Output:
+	// Red
+	// Green
+	// Blue
+}
+
+func TestColor_SQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Color
+		wantErr bool
+	}{
+		{
+			"string",
+			"Green",
+			Green,
+			false,
+		},
+		{
+			"bytes",
+			[]byte("Blue"),
+			Blue,
+			false,
+		},
+		{
+			"int64",
+			int64(1),
+			Green,
+			false,
+		},
+		{
+			"unknown name",
+			"Purple",
+			0,
+			true,
+		},
+		{
+			"undefined value",
+			int64(7),
+			0,
+			true,
+		},
+		{
+			"unsupported type",
+			1.5,
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Color
+			err := got.SQLScanner().Scan(tt.src)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_Value(t *testing.T) {
+	got, err := Blue.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "Blue" {
+		t.Errorf("Value() = %v, want %v", got, "Blue")
+	}
+
+	if _, err := Color(7).Value(); err == nil {
+		t.Error("Value() of an undefined value should return an error")
+	}
+}
+
+func TestColorValues(t *testing.T) {
+	got := ColorValues()
+	want := []Color{Red, Green, Blue}
+	if len(got) != ColorLen || len(got) != len(want) {
+		t.Fatalf("ColorValues() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ColorValues()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// modifying the returned slice must not affect later calls
+	got[0] = Blue
+	if ColorValues()[0] != Red {
+		t.Error("ColorValues() returned shared state")
+	}
+}
+
+func ExampleColorNames() {
+	for _, name := range ColorNames() {
+		fmt.Println(name)
+	}
+
+	// Output:
+	// Red
+	// Green
+	// Blue
+}
@@ -0,0 +1,413 @@
+// Code generated by "go-enumerator" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// elementNames holds the name of every Element value.
+const elementNames = "HydrogenHeliumLithiumBerylliumBoronCarbonNitrogenOxygenFluorineNeonSodiumMagnesiumAluminiumSiliconPhosphorusSulfurChlorineArgonPotassiumCalciumScandiumTitaniumVanadiumChromiumManganeseIronCobaltNickelCopperZincGalliumGermaniumArsenicSeleniumBromineKrypton"
+
+// elementNameIndex holds the offsets of each Element value in elementNames.
+var elementNameIndex = [...]uint8{0, 8, 14, 21, 30, 35, 41, 49, 55, 63, 67, 73, 82, 91, 98, 108, 114, 122, 127, 136, 143, 151, 159, 167, 175, 184, 188, 194, 200, 206, 210, 217, 226, 233, 241, 248, 255}
+
+// elementNameIndexOf returns the position of e in elementNameIndex, or -1 if !e.Defined().
+func elementNameIndexOf(e Element) int {
+	if e < 1 || e > 36 {
+		return -1
+	}
+	return int(e) - (1)
+}
+
+// String implements fmt.Stringer. If !e.Defined(), then a generated string is returned based on e's value.
+func (e Element) String() string {
+	if i := elementNameIndexOf(e); i >= 0 {
+		return elementNames[elementNameIndex[i]:elementNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Element(%d)", e)
+}
+
+// Bytes returns a byte-level representation of String(). If !e.Defined(), then a generated string is returned based on e's value.
+func (e Element) Bytes() []byte {
+	if i := elementNameIndexOf(e); i >= 0 {
+		return append([]byte(nil), elementNames[elementNameIndex[i]:elementNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Element(%d)", e))
+}
+
+// Defined returns true if e holds a defined value.
+func (e Element) Defined() bool {
+	switch e {
+	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36:
+		return true
+	default:
+		return false
+	}
+}
+
+// elementNameSeeds, elementNameKeys and elementNameValues form a perfect hash table of every name accepted by ParseElement.
+// A name with FNV-1a hash h is stored at index elementNameSlot(h).
+var (
+	elementNameSeeds  = [...]uint32{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 3, 0, 0, 0, 1, 2, 0, 0, 2, 0, 0, 0, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	elementNameKeys   = [...]string{"Cobalt", "", "", "Hydrogen", "Lithium", "Helium", "Fluorine", "", "", "", "", "", "", "Phosphorus", "Vanadium", "Iron", "Selenium", "Scandium", "", "", "Calcium", "Nitrogen", "Zinc", "", "Argon", "Titanium", "Manganese", "", "", "", "Boron", "Nickel", "Gallium", "Copper", "", "Germanium", "Magnesium", "", "", "Arsenic", "", "Sulfur", "", "Chromium", "", "Bromine", "Potassium", "Aluminium", "Beryllium", "Chlorine", "", "Sodium", "Carbon", "Oxygen", "", "Silicon", "", "Neon", "", "", "", "Krypton", "", ""}
+	elementNameValues = [...]Element{Cobalt, 0, 0, Hydrogen, Lithium, Helium, Fluorine, 0, 0, 0, 0, 0, 0, Phosphorus, Vanadium, Iron, Selenium, Scandium, 0, 0, Calcium, Nitrogen, Zinc, 0, Argon, Titanium, Manganese, 0, 0, 0, Boron, Nickel, Gallium, Copper, 0, Germanium, Magnesium, 0, 0, Arsenic, 0, Sulfur, 0, Chromium, 0, Bromine, Potassium, Aluminium, Beryllium, Chlorine, 0, Sodium, Carbon, Oxygen, 0, Silicon, 0, Neon, 0, 0, 0, Krypton, 0, 0}
+)
+
+// elementNameSlot returns the index in elementNameKeys of a name with FNV-1a hash h.
+func elementNameSlot(h uint32) uint32 {
+	h ^= elementNameSeeds[h&63]
+	h ^= h >> 16
+	h *= 0x7feb352d
+	h ^= h >> 15
+	h *= 0x846ca68b
+	h ^= h >> 16
+	return h & 63
+}
+
+// lookupElement returns the Element named s, and whether s is the name of a Element.
+func lookupElement(s string) (Element, bool) {
+	h := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+
+	i := elementNameSlot(h)
+	if len(s) == 0 || elementNameKeys[i] != s {
+		return 0, false
+	}
+	return elementNameValues[i], true
+}
+
+// lookupElementBytes is like lookupElement, but takes a []byte. It does not allocate.
+func lookupElementBytes(b []byte) (Element, bool) {
+	h := uint32(2166136261)
+	for i := 0; i < len(b); i++ {
+		h ^= uint32(b[i])
+		h *= 16777619
+	}
+
+	i := elementNameSlot(h)
+	if len(b) == 0 || elementNameKeys[i] != string(b) {
+		return 0, false
+	}
+	return elementNameValues[i], true
+}
+
+// ErrInvalidElement is matched by the errors returned when a Element cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidElement = errors.New("invalid Element")
+
+// InvalidElementError is the error returned when a string is not the name of a Element.
+// It matches ErrInvalidElement with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidElementError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidElementError) Error() string {
+	msg := fmt.Sprintf("invalid Element %q: must be one of Hydrogen, Helium, Lithium, Beryllium, Boron, Carbon, Nitrogen, Oxygen, Fluorine, Neon, Sodium, Magnesium, Aluminium, Silicon, Phosphorus, Sulfur, Chlorine, Argon, Potassium, Calcium, Scandium, Titanium, Vanadium, Chromium, Manganese, Iron, Cobalt, Nickel, Copper, Zinc, Gallium, Germanium, Arsenic, Selenium, Bromine or Krypton", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidElementError) Suggestions() []string {
+	return elementClosestNames(e.Input, []string{"Hydrogen", "Helium", "Lithium", "Beryllium", "Boron", "Carbon", "Nitrogen", "Oxygen", "Fluorine", "Neon", "Sodium", "Magnesium", "Aluminium", "Silicon", "Phosphorus", "Sulfur", "Chlorine", "Argon", "Potassium", "Calcium", "Scandium", "Titanium", "Vanadium", "Chromium", "Manganese", "Iron", "Cobalt", "Nickel", "Copper", "Zinc", "Gallium", "Germanium", "Arsenic", "Selenium", "Bromine", "Krypton"})
+}
+
+// Is returns true if target is ErrInvalidElement.
+func (e *InvalidElementError) Is(target error) bool {
+	return target == ErrInvalidElement
+}
+
+// ParseElement returns the Element named s. An error is returned if s is not the name of a Element.
+func ParseElement(s string) (Element, error) {
+	if x, ok := lookupElement(s); ok {
+		return x, nil
+	}
+	return 0, &InvalidElementError{Input: s}
+}
+
+// MustParseElement is like ParseElement, but panics if s cannot be parsed.
+func MustParseElement(s string) Element {
+	x, err := ParseElement(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Element values
+func (e *Element) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	if x, ok := lookupElementBytes(token); ok {
+		*e = x
+		return nil
+	}
+
+	x, err := ParseElement(string(token))
+	if err != nil {
+		return err
+	}
+	*e = x
+	return nil
+}
+
+// Next returns the next defined Element. If e is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	e := Element(0)
+//	for {
+//		fmt.Println(e)
+//		e = e.Next()
+//		if e == Element(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use ElementValues() to loop through the values in the order they are declared.
+func (e Element) Next() Element {
+	switch e {
+	case Hydrogen:
+		return Helium
+	case Helium:
+		return Lithium
+	case Lithium:
+		return Beryllium
+	case Beryllium:
+		return Boron
+	case Boron:
+		return Carbon
+	case Carbon:
+		return Nitrogen
+	case Nitrogen:
+		return Oxygen
+	case Oxygen:
+		return Fluorine
+	case Fluorine:
+		return Neon
+	case Neon:
+		return Sodium
+	case Sodium:
+		return Magnesium
+	case Magnesium:
+		return Aluminium
+	case Aluminium:
+		return Silicon
+	case Silicon:
+		return Phosphorus
+	case Phosphorus:
+		return Sulfur
+	case Sulfur:
+		return Chlorine
+	case Chlorine:
+		return Argon
+	case Argon:
+		return Potassium
+	case Potassium:
+		return Calcium
+	case Calcium:
+		return Scandium
+	case Scandium:
+		return Titanium
+	case Titanium:
+		return Vanadium
+	case Vanadium:
+		return Chromium
+	case Chromium:
+		return Manganese
+	case Manganese:
+		return Iron
+	case Iron:
+		return Cobalt
+	case Cobalt:
+		return Nickel
+	case Nickel:
+		return Copper
+	case Copper:
+		return Zinc
+	case Zinc:
+		return Gallium
+	case Gallium:
+		return Germanium
+	case Germanium:
+		return Arsenic
+	case Arsenic:
+		return Selenium
+	case Selenium:
+		return Bromine
+	case Bromine:
+		return Krypton
+	case Krypton:
+		return Hydrogen
+	default:
+		return Hydrogen
+	}
+}
+
+// ElementLen is the number of defined Element values.
+const ElementLen = 36
+
+// ElementValues returns all defined Element values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func ElementValues() []Element {
+	return []Element{Hydrogen, Helium, Lithium, Beryllium, Boron, Carbon, Nitrogen, Oxygen, Fluorine, Neon, Sodium, Magnesium, Aluminium, Silicon, Phosphorus, Sulfur, Chlorine, Argon, Potassium, Calcium, Scandium, Titanium, Vanadium, Chromium, Manganese, Iron, Cobalt, Nickel, Copper, Zinc, Gallium, Germanium, Arsenic, Selenium, Bromine, Krypton}
+}
+
+// ElementNames returns the names of all defined Element values in the same order as ElementValues.
+// A new slice is returned on every call, so it is safe to modify.
+func ElementNames() []string {
+	return []string{"Hydrogen", "Helium", "Lithium", "Beryllium", "Boron", "Carbon", "Nitrogen", "Oxygen", "Fluorine", "Neon", "Sodium", "Magnesium", "Aluminium", "Silicon", "Phosphorus", "Sulfur", "Chlorine", "Argon", "Potassium", "Calcium", "Scandium", "Titanium", "Vanadium", "Chromium", "Manganese", "Iron", "Cobalt", "Nickel", "Copper", "Zinc", "Gallium", "Germanium", "Arsenic", "Selenium", "Bromine", "Krypton"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[Hydrogen-1]
+	_ = x[Helium-2]
+	_ = x[Lithium-3]
+	_ = x[Beryllium-4]
+	_ = x[Boron-5]
+	_ = x[Carbon-6]
+	_ = x[Nitrogen-7]
+	_ = x[Oxygen-8]
+	_ = x[Fluorine-9]
+	_ = x[Neon-10]
+	_ = x[Sodium-11]
+	_ = x[Magnesium-12]
+	_ = x[Aluminium-13]
+	_ = x[Silicon-14]
+	_ = x[Phosphorus-15]
+	_ = x[Sulfur-16]
+	_ = x[Chlorine-17]
+	_ = x[Argon-18]
+	_ = x[Potassium-19]
+	_ = x[Calcium-20]
+	_ = x[Scandium-21]
+	_ = x[Titanium-22]
+	_ = x[Vanadium-23]
+	_ = x[Chromium-24]
+	_ = x[Manganese-25]
+	_ = x[Iron-26]
+	_ = x[Cobalt-27]
+	_ = x[Nickel-28]
+	_ = x[Copper-29]
+	_ = x[Zinc-30]
+	_ = x[Gallium-31]
+	_ = x[Germanium-32]
+	_ = x[Arsenic-33]
+	_ = x[Selenium-34]
+	_ = x[Bromine-35]
+	_ = x[Krypton-36]
+}
+
+// MarshalJSON implements json.Marshaler
+func (e Element) MarshalJSON() ([]byte, error) {
+	if i := elementNameIndexOf(e); i >= 0 {
+		name := elementNames[elementNameIndex[i]:elementNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *Element) UnmarshalJSON(x []byte) error {
+	if len(x) >= 2 && x[0] == '"' && x[len(x)-1] == '"' && bytes.IndexByte(x[1:len(x)-1], '\\') < 0 {
+		if y, ok := lookupElementBytes(x[1 : len(x)-1]); ok {
+			*e = y
+			return nil
+		}
+	}
+
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseElement(str)
+	if err != nil {
+		return err
+	}
+	*e = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (e Element) MarshalText() ([]byte, error) {
+	return e.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (e *Element) UnmarshalText(x []byte) error {
+	if y, ok := lookupElementBytes(x); ok {
+		*e = y
+		return nil
+	}
+
+	y, err := ParseElement(string(x))
+	if err != nil {
+		return err
+	}
+	*e = y
+	return nil
+}
+
+// elementClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func elementClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
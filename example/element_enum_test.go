@@ -0,0 +1,122 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseElement(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Element
+		wantErr bool
+	}{
+		{
+			"Hydrogen",
+			"Hydrogen",
+			Hydrogen,
+			false,
+		},
+		{
+			"Krypton",
+			"Krypton",
+			Krypton,
+			false,
+		},
+		{
+			"wrong case",
+			"hydrogen",
+			0,
+			true,
+		},
+		{
+			"undefined",
+			"Rubidium",
+			0,
+			true,
+		},
+		{
+			"empty",
+			"",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseElement(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseElement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseElement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElement_RoundTrip(t *testing.T) {
+	for _, e := range ElementValues() {
+		var text Element
+		if err := text.UnmarshalText(e.Bytes()); err != nil || text != e {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", e.Bytes(), text, err, e)
+		}
+
+		x, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var js Element
+		if err := json.Unmarshal(x, &js); err != nil || js != e {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", x, js, err, e)
+		}
+	}
+}
+
+func TestElement_UnmarshalJSON(t *testing.T) {
+	var e Element
+	if err := json.Unmarshal([]byte(`"Iron"`), &e); err != nil || e != Iron {
+		t.Errorf("json.Unmarshal() = %v, %v, want %v", e, err, Iron)
+	}
+
+	if err := json.Unmarshal([]byte(`"Rubidium"`), &e); err == nil {
+		t.Errorf("json.Unmarshal() error = nil, want error")
+	}
+}
+
+func TestElement_Allocs(t *testing.T) {
+	text, js := []byte("Germanium"), []byte(`"Germanium"`)
+	allocs := testing.AllocsPerRun(100, func() {
+		var e Element
+		_, _ = ParseElement("Germanium")
+		_ = e.UnmarshalText(text)
+		_ = e.UnmarshalJSON(js)
+	})
+	if allocs != 0 {
+		t.Errorf("AllocsPerRun() = %v, want 0", allocs)
+	}
+}
+
+var benchmarkElement Element
+
+func BenchmarkParseElement(b *testing.B) {
+	names := ElementNames()
+	for i := 0; i < b.N; i++ {
+		benchmarkElement, _ = ParseElement(names[i%len(names)])
+	}
+}
+
+func BenchmarkElement_UnmarshalJSON(b *testing.B) {
+	var inputs [][]byte
+	for _, e := range ElementValues() {
+		x, _ := e.MarshalJSON()
+		inputs = append(inputs, x)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = benchmarkElement.UnmarshalJSON(inputs[i%len(inputs)])
+	}
+}
@@ -1,7 +1,8 @@
 package example
 
-//go:generate go-enumerator
 // Kind demonstrates integer style enums
+//
+//go:generate go-enumerator
 type Kind int
 
 const (
@@ -9,11 +10,231 @@ const (
 	Kind2
 )
 
-//go:generate go-enumerator
-// StrKind demonstrates string style enums
+// StrKind demonstrates string style enums, and generated tests
+//
+//go:generate go-enumerator --tests --fuzz
 type StrKind string
 
 const (
 	Hello StrKind = "Hello"
 	World StrKind = "World"
 )
+
+// Color demonstrates enums with aliases
+//
+//go:generate go-enumerator --sql name
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+
+	// Default is an alias of Red. String() returns "Red" for both,
+	// but Scan() accepts either name.
+	Default = Red
+)
+
+// Perm demonstrates bit flag style enums
+//
+//go:generate go-enumerator --sql value
+type Perm uint8
+
+const (
+	Read Perm = 1 << iota
+	Write
+	Exec
+
+	// ReadWrite is a combination of flags. String() returns "ReadWrite" for it.
+	ReadWrite = Read | Write
+)
+
+// Shade demonstrates transforming the names of constants
+//
+//go:generate go-enumerator --trim-prefix Shade --transform snake
+type Shade int
+
+const (
+	ShadeLight Shade = iota
+	ShadeDarkBlue
+	ShadeHTMLGray
+)
+
+// Tone demonstrates names that contain spaces
+//
+//go:generate go-enumerator --trim-prefix Tone --transform title --tests
+type Tone int
+
+const (
+	ToneBlack Tone = iota
+	ToneLightRed
+	ToneDarkBlue
+)
+
+// Status demonstrates overriding the names of constants with comment directives,
+// parse-only aliases, and case-insensitive parsing
+//
+//go:generate go-enumerator --ignore-case
+type Status int
+
+const (
+	StatusOK Status = iota // enum:"ok"
+	// enum:name=in-progress alias=pending,started
+	StatusInProgress
+	StatusFailed
+)
+
+// Weekday demonstrates the default table layout, where String() slices names out of a table
+//
+//go:generate go-enumerator
+type Weekday int
+
+const (
+	Sunday Weekday = iota
+	Monday
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+)
+
+// SwitchWeekday has the same names as Weekday, but demonstrates the switch layout
+//
+//go:generate go-enumerator --layout switch --trim-prefix Switch
+type SwitchWeekday int
+
+const (
+	SwitchSunday SwitchWeekday = iota
+	SwitchMonday
+	SwitchTuesday
+	SwitchWednesday
+	SwitchThursday
+	SwitchFriday
+	SwitchSaturday
+)
+
+// Signal demonstrates sparse values, which are found in the table with a map lookup, and generated tests
+//
+//go:generate go-enumerator --tests --fuzz
+type Signal int
+
+const (
+	SignalHangup    Signal = 1
+	SignalInterrupt Signal = 2
+	SignalKill      Signal = 9
+	SignalTerminate Signal = 15
+)
+
+// Element demonstrates enums with enough names to be parsed with a perfect hash table
+//
+//go:generate go-enumerator
+type Element int
+
+const (
+	Hydrogen Element = iota + 1
+	Helium
+	Lithium
+	Beryllium
+	Boron
+	Carbon
+	Nitrogen
+	Oxygen
+	Fluorine
+	Neon
+	Sodium
+	Magnesium
+	Aluminium
+	Silicon
+	Phosphorus
+	Sulfur
+	Chlorine
+	Argon
+	Potassium
+	Calcium
+	Scandium
+	Titanium
+	Vanadium
+	Chromium
+	Manganese
+	Iron
+	Cobalt
+	Nickel
+	Copper
+	Zinc
+	Gallium
+	Germanium
+	Arsenic
+	Selenium
+	Bromine
+	Krypton
+)
+
+// Class demonstrates string enums with enough values to be parsed with a perfect hash table,
+// whose values contain backslashes that are escaped in JSON
+//
+//go:generate go-enumerator
+type Class string
+
+const (
+	ClassDigit     Class = `\d`
+	ClassNotDigit  Class = `\D`
+	ClassWord      Class = `\w`
+	ClassNotWord   Class = `\W`
+	ClassSpace     Class = `\s`
+	ClassNotSpace  Class = `\S`
+	ClassLetter    Class = `\pL`
+	ClassNotLetter Class = `\PL`
+	ClassNumber    Class = `\pN`
+	ClassNotNumber Class = `\PN`
+	ClassPunct     Class = `\pP`
+	ClassNotPunct  Class = `\PP`
+	ClassSymbol    Class = `\pS`
+	ClassNotSymbol Class = `\PS`
+	ClassNewline   Class = `\n`
+	ClassAny       Class = `.`
+)
+
+// Suit demonstrates a type that declares its own String() method, which is not generated,
+// and skipping the JSON and text methods in .go-enumerator.yaml
+//
+//go:generate go-enumerator
+type Suit int
+
+const (
+	Spades Suit = iota
+	Hearts
+	Diamonds
+	Clubs
+)
+
+// String returns the symbol of s.
+func (s Suit) String() string {
+	if !s.Defined() {
+		return "?"
+	}
+	return string("♠♥♦♣"[s*3 : s*3+3])
+}
+
+// Speed demonstrates floating-point enums
+//
+//go:generate go-enumerator --sql value
+type Speed float64
+
+const (
+	SpeedSlow   Speed = 0.5
+	SpeedNormal Speed = 1
+	SpeedFast   Speed = 1.5
+)
+
+// Answer demonstrates bool enums
+//
+//go:generate go-enumerator --tests
+type Answer bool
+
+const (
+	No  Answer = false
+	Yes Answer = true
+)
+
+//go:generate go-enumerator --type github.com/ajjensen13/go-enumerator/example/units.Unit
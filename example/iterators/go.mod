@@ -0,0 +1,3 @@
+module github.com/ajjensen13/go-enumerator/example/iterators
+
+go 1.23
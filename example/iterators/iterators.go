@@ -0,0 +1,14 @@
+// Package iterators is in a module of its own, so that it can use Go 1.23.
+// go-enumerator generates KindAll() and KindAllIndexed() for the modules that can range over them.
+package iterators
+
+//go:generate go-enumerator
+type Kind int
+
+const (
+	KindA Kind = iota + 1
+	KindB
+	KindC
+	// KindDefault is an alias of KindA. Iterators visit it once, as KindA.
+	KindDefault = KindA
+)
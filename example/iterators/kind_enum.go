@@ -0,0 +1,283 @@
+// Code generated by "go-enumerator" (devel); DO NOT EDIT.
+
+package iterators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// kindNames holds the name of every Kind value.
+const kindNames = "KindAKindBKindC"
+
+// kindNameIndex holds the offsets of each Kind value in kindNames.
+var kindNameIndex = [...]uint8{0, 5, 10, 15}
+
+// kindNameIndexOf returns the position of k in kindNameIndex, or -1 if !k.Defined().
+func kindNameIndexOf(k Kind) int {
+	if k < 1 || k > 3 {
+		return -1
+	}
+	return int(k) - (1)
+}
+
+// String implements fmt.Stringer. If !k.Defined(), then a generated string is returned based on k's value.
+func (k Kind) String() string {
+	if i := kindNameIndexOf(k); i >= 0 {
+		return kindNames[kindNameIndex[i]:kindNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Kind(%d)", k)
+}
+
+// Bytes returns a byte-level representation of String(). If !k.Defined(), then a generated string is returned based on k's value.
+func (k Kind) Bytes() []byte {
+	if i := kindNameIndexOf(k); i >= 0 {
+		return append([]byte(nil), kindNames[kindNameIndex[i]:kindNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Kind(%d)", k))
+}
+
+// Defined returns true if k holds a defined value.
+func (k Kind) Defined() bool {
+	switch k {
+	case 1, 2, 3:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidKind is matched by the errors returned when a Kind cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidKind = errors.New("invalid Kind")
+
+// InvalidKindError is the error returned when a string is not the name of a Kind.
+// It matches ErrInvalidKind with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidKindError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidKindError) Error() string {
+	msg := fmt.Sprintf("invalid Kind %q: must be one of KindA, KindB or KindC", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidKindError) Suggestions() []string {
+	return kindClosestNames(e.Input, []string{"KindA", "KindB", "KindC", "KindDefault"})
+}
+
+// Is returns true if target is ErrInvalidKind.
+func (e *InvalidKindError) Is(target error) bool {
+	return target == ErrInvalidKind
+}
+
+// ParseKind returns the Kind named s. An error is returned if s is not the name of a Kind.
+func ParseKind(s string) (Kind, error) {
+	switch s {
+	case "KindA":
+		return KindA, nil
+	case "KindB":
+		return KindB, nil
+	case "KindC":
+		return KindC, nil
+	case "KindDefault":
+		return KindDefault, nil
+	default:
+		return 0, &InvalidKindError{Input: s}
+	}
+}
+
+// MustParseKind is like ParseKind, but panics if s cannot be parsed.
+func MustParseKind(s string) Kind {
+	x, err := ParseKind(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Kind values
+func (k *Kind) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseKind(string(token))
+	if err != nil {
+		return err
+	}
+	*k = x
+	return nil
+}
+
+// Next returns the next defined Kind. If k is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	k := Kind(0)
+//	for {
+//		fmt.Println(k)
+//		k = k.Next()
+//		if k == Kind(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use KindValues() to loop through the values in the order they are declared.
+func (k Kind) Next() Kind {
+	switch k {
+	case KindA:
+		return KindB
+	case KindB:
+		return KindC
+	case KindC:
+		return KindA
+	default:
+		return KindA
+	}
+}
+
+// KindLen is the number of defined Kind values.
+const KindLen = 3
+
+// KindValues returns all defined Kind values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func KindValues() []Kind {
+	return []Kind{KindA, KindB, KindC}
+}
+
+// KindNames returns the names of all defined Kind values in the same order as KindValues.
+// A new slice is returned on every call, so it is safe to modify.
+func KindNames() []string {
+	return []string{"KindA", "KindB", "KindC"}
+}
+
+// KindAll returns an iterator over all defined Kind values in the order they are declared.
+//
+//	for k := range KindAll() {
+//		fmt.Println(k)
+//	}
+func KindAll() iter.Seq[Kind] {
+	return func(yield func(Kind) bool) {
+		for _, x := range [...]Kind{KindA, KindB, KindC} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// KindAllIndexed returns an iterator over all defined Kind values and their ordinals in the order they are declared.
+func KindAllIndexed() iter.Seq2[int, Kind] {
+	return func(yield func(int, Kind) bool) {
+		for i, x := range [...]Kind{KindA, KindB, KindC} {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[KindA-1]
+	_ = x[KindB-2]
+	_ = x[KindC-3]
+	_ = x[KindDefault-1]
+}
+
+// MarshalJSON implements json.Marshaler
+func (k Kind) MarshalJSON() ([]byte, error) {
+	if i := kindNameIndexOf(k); i >= 0 {
+		name := kindNames[kindNameIndex[i]:kindNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (k *Kind) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseKind(str)
+	if err != nil {
+		return err
+	}
+	*k = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k Kind) MarshalText() ([]byte, error) {
+	return k.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *Kind) UnmarshalText(x []byte) error {
+	y, err := ParseKind(string(x))
+	if err != nil {
+		return err
+	}
+	*k = y
+	return nil
+}
+
+// kindClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func kindClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,68 @@
+package iterators
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleKindAll() {
+	for k := range KindAll() {
+		fmt.Println(k)
+	}
+
+	// Output:
+	// KindA
+	// KindB
+	// KindC
+}
+
+func ExampleKindAllIndexed() {
+	for i, k := range KindAllIndexed() {
+		fmt.Println(i, k)
+	}
+
+	// Output:
+	// 0 KindA
+	// 1 KindB
+	// 2 KindC
+}
+
+func TestKindAll(t *testing.T) {
+	var got []Kind
+	for k := range KindAll() {
+		got = append(got, k)
+	}
+
+	want := KindValues()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("KindAll() = %v, want %v", got, want)
+	}
+}
+
+func TestKindAll_Break(t *testing.T) {
+	var got []Kind
+	for k := range KindAll() {
+		got = append(got, k)
+		if k == KindB {
+			break
+		}
+	}
+
+	if want := []Kind{KindA, KindB}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("KindAll() visited %v before break, want %v", got, want)
+	}
+}
+
+func TestKindAllIndexed_Break(t *testing.T) {
+	n := 0
+	for i := range KindAllIndexed() {
+		n++
+		if i == 0 {
+			break
+		}
+	}
+
+	if n != 1 {
+		t.Errorf("KindAllIndexed() yielded %d values before break, want 1", n)
+	}
+}
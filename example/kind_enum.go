@@ -1,27 +1,40 @@
-// Code generated by "go-enumerator"; DO NOT EDIT.
+// Code generated by "go-enumerator" (devel); DO NOT EDIT.
 
 package example
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// kindNames holds the name of every Kind value.
+const kindNames = "Kind1Kind2"
+
+// kindNameIndex holds the offsets of each Kind value in kindNames.
+var kindNameIndex = [...]uint8{0, 5, 10}
+
+// kindNameIndexOf returns the position of k in kindNameIndex, or -1 if !k.Defined().
+func kindNameIndexOf(k Kind) int {
+	if k < 0 || k > 1 {
+		return -1
+	}
+	return int(k)
+}
 
 // String implements fmt.Stringer. If !k.Defined(), then a generated string is returned based on k's value.
 func (k Kind) String() string {
-	switch k {
-	case Kind1:
-		return "Kind1"
-	case Kind2:
-		return "Kind2"
+	if i := kindNameIndexOf(k); i >= 0 {
+		return kindNames[kindNameIndex[i]:kindNameIndex[i+1]]
 	}
 	return fmt.Sprintf("Kind(%d)", k)
 }
 
 // Bytes returns a byte-level representation of String(). If !k.Defined(), then a generated string is returned based on k's value.
 func (k Kind) Bytes() []byte {
-	switch k {
-	case Kind1:
-		return []byte{'K', 'i', 'n', 'd', '1'}
-	case Kind2:
-		return []byte{'K', 'i', 'n', 'd', '2'}
+	if i := kindNameIndexOf(k); i >= 0 {
+		return append([]byte(nil), kindNames[kindNameIndex[i]:kindNameIndex[i+1]]...)
 	}
 	return []byte(fmt.Sprintf("Kind(%d)", k))
 }
@@ -36,6 +49,58 @@ func (k Kind) Defined() bool {
 	}
 }
 
+// ErrInvalidKind is matched by the errors returned when a Kind cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidKind = errors.New("invalid Kind")
+
+// InvalidKindError is the error returned when a string is not the name of a Kind.
+// It matches ErrInvalidKind with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidKindError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidKindError) Error() string {
+	msg := fmt.Sprintf("invalid Kind %q: must be one of Kind1 or Kind2", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidKindError) Suggestions() []string {
+	return kindClosestNames(e.Input, []string{"Kind1", "Kind2"})
+}
+
+// Is returns true if target is ErrInvalidKind.
+func (e *InvalidKindError) Is(target error) bool {
+	return target == ErrInvalidKind
+}
+
+// ParseKind returns the Kind named s. An error is returned if s is not the name of a Kind.
+func ParseKind(s string) (Kind, error) {
+	switch s {
+	case "Kind1":
+		return Kind1, nil
+	case "Kind2":
+		return Kind2, nil
+	default:
+		return 0, &InvalidKindError{Input: s}
+	}
+}
+
+// MustParseKind is like ParseKind, but panics if s cannot be parsed.
+func MustParseKind(s string) Kind {
+	x, err := ParseKind(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
 // Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Kind values
 func (k *Kind) Scan(scanState fmt.ScanState, verb rune) error {
 	token, err := scanState.Token(true, nil)
@@ -43,14 +108,11 @@ func (k *Kind) Scan(scanState fmt.ScanState, verb rune) error {
 		return err
 	}
 
-	switch string(token) {
-	case "Kind1":
-		*k = Kind1
-	case "Kind2":
-		*k = Kind2
-	default:
-		return fmt.Errorf("unknown Kind value: %s", token)
+	x, err := ParseKind(string(token))
+	if err != nil {
+		return err
 	}
+	*k = x
 	return nil
 }
 
@@ -67,6 +129,7 @@ func (k *Kind) Scan(scanState fmt.ScanState, verb rune) error {
 //	}
 //
 // The exact order that values are returned when looping should not be relied upon.
+// Use KindValues() to loop through the values in the order they are declared.
 func (k Kind) Next() Kind {
 	switch k {
 	case Kind1:
@@ -78,6 +141,21 @@ func (k Kind) Next() Kind {
 	}
 }
 
+// KindLen is the number of defined Kind values.
+const KindLen = 2
+
+// KindValues returns all defined Kind values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func KindValues() []Kind {
+	return []Kind{Kind1, Kind2}
+}
+
+// KindNames returns the names of all defined Kind values in the same order as KindValues.
+// A new slice is returned on every call, so it is safe to modify.
+func KindNames() []string {
+	return []string{"Kind1", "Kind2"}
+}
+
 func _() {
 	var x [1]struct{}
 	// An "invalid array index" compiler error signifies that the constant values have changed.
@@ -88,21 +166,83 @@ func _() {
 
 // MarshalJSON implements json.Marshaler
 func (k Kind) MarshalJSON() ([]byte, error) {
-	x := k.Bytes()
-	y := make([]byte, 0, len(x))
-	return append(append(append(y, '"'), x...), '"'), nil
+	if i := kindNameIndexOf(k); i >= 0 {
+		name := kindNames[kindNameIndex[i]:kindNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(k.String())
 }
 
 // UnmarshalJSON implements json.Unmarshaler
 func (k *Kind) UnmarshalJSON(x []byte) error {
-	switch string(x) {
-	case "\"Kind1\"":
-		*k = Kind1
-		return nil
-	case "\"Kind2\"":
-		*k = Kind2
-		return nil
-	default:
-		return fmt.Errorf("failed to parse value %v into %T", x, *k)
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseKind(str)
+	if err != nil {
+		return err
+	}
+	*k = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k Kind) MarshalText() ([]byte, error) {
+	return k.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *Kind) UnmarshalText(x []byte) error {
+	y, err := ParseKind(string(x))
+	if err != nil {
+		return err
+	}
+	*k = y
+	return nil
+}
+
+// kindClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func kindClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
 	}
+	return ret
 }
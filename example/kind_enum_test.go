@@ -1,6 +1,8 @@
 package example
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -203,6 +205,25 @@ func TestKind_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestKind_BytesCopy(t *testing.T) {
+	b := Kind1.Bytes()
+	b[0] = 'X'
+	_ = append(b[:1], "YZ"...)
+
+	j, err := Kind2.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	j[1] = 'X'
+
+	if got := Kind1.String(); got != "Kind1" {
+		t.Errorf("Kind1.String() = %q after modifying Bytes(), want %q", got, "Kind1")
+	}
+	if got := Kind2.String(); got != "Kind2" {
+		t.Errorf("Kind2.String() = %q after modifying MarshalJSON(), want %q", got, "Kind2")
+	}
+}
+
 func TestKind_UnmarshalJSON(t *testing.T) {
 	expected := Kind(0)
 	for {
@@ -227,3 +248,188 @@ func TestKind_UnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestKind_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Kind
+		wantErr bool
+	}{
+		{
+			"Kind1",
+			"Kind1",
+			Kind1,
+			false,
+		},
+		{
+			"Kind2",
+			"Kind2",
+			Kind2,
+			false,
+		},
+		{
+			"unknown",
+			"Kind3",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Kind
+			err := got.UnmarshalText([]byte(tt.input))
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleKind_MarshalText() {
+	counts := map[Kind]int{Kind1: 1, Kind2: 2}
+	data, _ := json.Marshal(counts)
+	fmt.Println(string(data))
+
+	// Output:
+	// {"Kind1":1,"Kind2":2}
+}
+
+func TestParseKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Kind
+		wantErr bool
+	}{
+		{
+			"Kind1",
+			"Kind1",
+			Kind1,
+			false,
+		},
+		{
+			"Kind2",
+			"Kind2",
+			Kind2,
+			false,
+		},
+		{
+			"quoted",
+			`"Kind1"`,
+			0,
+			true,
+		},
+		{
+			"empty",
+			"",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseKind(tt.input)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustParseKind(t *testing.T) {
+	if got := MustParseKind("Kind2"); got != Kind2 {
+		t.Errorf("MustParseKind() = %v, want %v", got, Kind2)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseKind() did not panic")
+		}
+	}()
+	MustParseKind("Kind3")
+}
+
+func TestKind_InvalidError(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() error
+		input string
+	}{
+		{
+			"ParseKind",
+			func() error {
+				_, err := ParseKind("Kind3")
+				return err
+			},
+			"Kind3",
+		},
+		{
+			"UnmarshalJSON",
+			func() error {
+				var k Kind
+				return json.Unmarshal([]byte(`"kind1"`), &k)
+			},
+			"kind1",
+		},
+		{
+			"UnmarshalText",
+			func() error {
+				var k Kind
+				return k.UnmarshalText([]byte(""))
+			},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse()
+			if !errors.Is(err, ErrInvalidKind) {
+				t.Fatalf("errors.Is(%v, ErrInvalidKind) = false, want true", err)
+			}
+
+			var target *InvalidKindError
+			if !errors.As(err, &target) {
+				t.Fatalf("errors.As(%v) = false, want true", err)
+			}
+			if target.Input != tt.input {
+				t.Errorf("Input = %q, want %q", target.Input, tt.input)
+			}
+		})
+	}
+}
+
+func ExampleInvalidKindError() {
+	var k Kind
+	err := json.Unmarshal([]byte(`"Kind3"`), &k)
+
+	var target *InvalidKindError
+	if errors.As(err, &target) {
+		fmt.Println("bad input:", target.Input)
+	}
+	fmt.Println(errors.Is(err, ErrInvalidKind))
+	fmt.Println(err)
+
+	// Output:
+	// bad input: Kind3
+	// true
+	// invalid Kind "Kind3": must be one of Kind1 or Kind2 (did you mean Kind1 or Kind2?)
+}
+
+func ExampleParseKind() {
+	k, err := ParseKind("Kind2")
+	fmt.Println(k, err)
+
+	_, err = ParseKind("Kind3")
+	fmt.Println(err)
+
+	// Output:
+	// Kind2 <nil>
+	// invalid Kind "Kind3": must be one of Kind1 or Kind2 (did you mean Kind1 or Kind2?)
+}
@@ -0,0 +1,354 @@
+// Code generated by "go-enumerator --sql=value" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String implements fmt.Stringer. If p is not a single defined value, then the names of the flags that are set are joined with "|".
+func (p Perm) String() string {
+	switch p {
+	case Read:
+		return "Read"
+	case Write:
+		return "Write"
+	case Exec:
+		return "Exec"
+	case ReadWrite:
+		return "ReadWrite"
+	}
+	return string(p.Bytes())
+}
+
+// Bytes returns a byte-level representation of String(). Any bits of p that do not belong to a defined flag are formatted based on their value.
+func (p Perm) Bytes() []byte {
+	switch p {
+	case Read:
+		return []byte{'R', 'e', 'a', 'd'}
+	case Write:
+		return []byte{'W', 'r', 'i', 't', 'e'}
+	case Exec:
+		return []byte{'E', 'x', 'e', 'c'}
+	case ReadWrite:
+		return []byte{'R', 'e', 'a', 'd', 'W', 'r', 'i', 't', 'e'}
+	}
+
+	var x []byte
+	y := p
+	if y&Read != 0 {
+		x = append(x, "|Read"...)
+		y &^= Read
+	}
+	if y&Write != 0 {
+		x = append(x, "|Write"...)
+		y &^= Write
+	}
+	if y&Exec != 0 {
+		x = append(x, "|Exec"...)
+		y &^= Exec
+	}
+	if y != 0 || len(x) == 0 {
+		x = append(x, fmt.Sprintf("|Perm(%d)", y)...)
+	}
+	return x[1:]
+}
+
+// Defined returns true if p holds a defined value or a combination of defined flags.
+func (p Perm) Defined() bool {
+	return p != 0 && p&^(Read|Write|Exec) == 0
+}
+
+// ErrInvalidPerm is matched by the errors returned when a Perm cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidPerm = errors.New("invalid Perm")
+
+// InvalidPermError is the error returned when a string is not the name of a Perm.
+// It matches ErrInvalidPerm with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidPermError struct {
+	// Input is the flag name that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidPermError) Error() string {
+	msg := fmt.Sprintf("invalid Perm %q: must be one of Read, Write, Exec or ReadWrite", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidPermError) Suggestions() []string {
+	return permClosestNames(e.Input, []string{"Read", "Write", "Exec", "ReadWrite"})
+}
+
+// Is returns true if target is ErrInvalidPerm.
+func (e *InvalidPermError) Is(target error) bool {
+	return target == ErrInvalidPerm
+}
+
+// ParsePerm returns the Perm named s. Multiple flags can be separated by "|".
+// An error is returned if s contains a name that is not defined.
+func ParsePerm(s string) (Perm, error) {
+	var x Perm
+	for _, str := range strings.Split(s, "|") {
+		switch str {
+		case "Read":
+			x |= Read
+		case "Write":
+			x |= Write
+		case "Exec":
+			x |= Exec
+		case "ReadWrite":
+			x |= ReadWrite
+		default:
+			return 0, &InvalidPermError{Input: str}
+		}
+	}
+	return x, nil
+}
+
+// MustParsePerm is like ParsePerm, but panics if s cannot be parsed.
+func MustParsePerm(s string) Perm {
+	x, err := ParsePerm(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Perm values
+func (p *Perm) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParsePerm(string(token))
+	if err != nil {
+		return err
+	}
+	*p = x
+	return nil
+}
+
+// Has returns true if all the flags set in flags are also set in p.
+func (p Perm) Has(flags Perm) bool {
+	return p&flags == flags
+}
+
+// Set returns p with the flags set in flags set.
+func (p Perm) Set(flags Perm) Perm {
+	return p | flags
+}
+
+// Clear returns p with the flags set in flags cleared.
+func (p Perm) Clear(flags Perm) Perm {
+	return p &^ flags
+}
+
+// Toggle returns p with the flags set in flags toggled.
+func (p Perm) Toggle(flags Perm) Perm {
+	return p ^ flags
+}
+
+// Next returns the next defined Perm. If p is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	p := Perm(0)
+//	for {
+//		fmt.Println(p)
+//		p = p.Next()
+//		if p == Perm(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use PermValues() to loop through the values in the order they are declared.
+func (p Perm) Next() Perm {
+	switch p {
+	case Read:
+		return Write
+	case Write:
+		return Exec
+	case Exec:
+		return ReadWrite
+	case ReadWrite:
+		return Read
+	default:
+		return Read
+	}
+}
+
+// PermLen is the number of defined Perm values.
+const PermLen = 4
+
+// PermValues returns all defined Perm values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func PermValues() []Perm {
+	return []Perm{Read, Write, Exec, ReadWrite}
+}
+
+// PermNames returns the names of all defined Perm values in the same order as PermValues.
+// A new slice is returned on every call, so it is safe to modify.
+func PermNames() []string {
+	return []string{"Read", "Write", "Exec", "ReadWrite"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[Read-1]
+	_ = x[Write-2]
+	_ = x[Exec-4]
+	_ = x[ReadWrite-3]
+}
+
+// MarshalJSON implements json.Marshaler
+func (p Perm) MarshalJSON() ([]byte, error) {
+	x := p.Bytes()
+	y := make([]byte, 0, len(x))
+	return append(append(append(y, '"'), x...), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Perm) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParsePerm(str)
+	if err != nil {
+		return err
+	}
+	*p = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (p Perm) MarshalText() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *Perm) UnmarshalText(x []byte) error {
+	y, err := ParsePerm(string(x))
+	if err != nil {
+		return err
+	}
+	*p = y
+	return nil
+}
+
+// Value implements driver.Valuer. The underlying value of p is stored. An error is returned if !p.Defined().
+func (p Perm) Value() (driver.Value, error) {
+	if !p.Defined() {
+		return nil, fmt.Errorf("undefined Perm value: %v", p)
+	}
+	return int64(p), nil
+}
+
+// SQLScanner returns a sql.Scanner that stores the values it scans in p.
+// Perm implements fmt.Scanner, so it cannot implement sql.Scanner directly.
+//
+//	err := row.Scan(p.SQLScanner())
+func (p *Perm) SQLScanner() sql.Scanner {
+	return (*permSQLScanner)(p)
+}
+
+// permSQLScanner implements sql.Scanner for Perm.
+type permSQLScanner Perm
+
+// Scan implements sql.Scanner. string, []byte and int64 values are accepted.
+func (p *permSQLScanner) Scan(x interface{}) error {
+	var y Perm
+	switch x := x.(type) {
+	case string:
+		v, err := strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse value %q into Perm: %w", x, err)
+		}
+		y = Perm(v)
+		if v < 0 || int64(y) != v {
+			return fmt.Errorf("value %d is out of range for Perm", v)
+		}
+	case []byte:
+		v, err := strconv.ParseInt(string(x), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse value %q into Perm: %w", string(x), err)
+		}
+		y = Perm(v)
+		if v < 0 || int64(y) != v {
+			return fmt.Errorf("value %d is out of range for Perm", v)
+		}
+	case int64:
+		y = Perm(x)
+		if x < 0 || int64(y) != x {
+			return fmt.Errorf("value %d is out of range for Perm", x)
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into Perm", x)
+	}
+
+	if !y.Defined() {
+		return fmt.Errorf("undefined Perm value: %v", y)
+	}
+	*p = permSQLScanner(y)
+	return nil
+}
+
+// permClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func permClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,214 @@
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestPerm_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Perm
+		want string
+	}{
+		{
+			"Read",
+			Read,
+			"Read",
+		},
+		{
+			"ReadWrite",
+			Read | Write,
+			"ReadWrite",
+		},
+		{
+			"Read|Exec",
+			Read | Exec,
+			"Read|Exec",
+		},
+		{
+			"undefined bits",
+			Exec | 16,
+			"Exec|Perm(16)",
+		},
+		{
+			"zero",
+			0,
+			"Perm(0)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerm_Defined(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Perm
+		want bool
+	}{
+		{
+			"Read",
+			Read,
+			true,
+		},
+		{
+			"Read|Write|Exec",
+			Read | Write | Exec,
+			true,
+		},
+		{
+			"undefined bits",
+			Read | 16,
+			false,
+		},
+		{
+			"zero",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Defined(); got != tt.want {
+				t.Errorf("Defined() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerm_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Perm
+		wantErr bool
+	}{
+		{
+			"Read",
+			"Read",
+			Read,
+			false,
+		},
+		{
+			"Read|Exec",
+			"Read|Exec",
+			Read | Exec,
+			false,
+		},
+		{
+			"ReadWrite|Exec",
+			"ReadWrite|Exec",
+			Read | Write | Exec,
+			false,
+		},
+		{
+			"unknown",
+			"Read|Delete",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Perm
+			_, err := fmt.Sscan(tt.input, &got)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerm_InvalidError(t *testing.T) {
+	_, err := ParsePerm("Read|Delete")
+
+	var target *InvalidPermError
+	if !errors.As(err, &target) {
+		t.Fatalf("errors.As(%v) = false, want true", err)
+	}
+	if target.Input != "Delete" {
+		t.Errorf("Input = %q, want %q", target.Input, "Delete")
+	}
+	if !errors.Is(err, ErrInvalidPerm) {
+		t.Errorf("errors.Is(%v, ErrInvalidPerm) = false, want true", err)
+	}
+}
+
+func TestPerm_JSON(t *testing.T) {
+	want := Write | Exec
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"Write|Exec"` {
+		t.Errorf("MarshalJSON() = %s, want %s", data, `"Write|Exec"`)
+	}
+
+	var got Perm
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, want)
+	}
+}
+
+func ExamplePerm_Has() {
+	p := Read.Set(Exec)
+	fmt.Println(p.Has(Read))
+	fmt.Println(p.Has(Write))
+	fmt.Println(p.Toggle(Write).Clear(Read))
+
+	// Output:
+	// true
+	// false
+	// Write|Exec
+}
+
+func TestPerm_SQL(t *testing.T) {
+	want := Read | Exec
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(5) {
+		t.Errorf("Value() = %v, want %v", v, int64(5))
+	}
+
+	var got Perm
+	if err := got.SQLScanner().Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Scan() = %v, want %v", got, want)
+	}
+
+	if err := got.SQLScanner().Scan([]byte("3")); err != nil {
+		t.Fatal(err)
+	}
+	if got != ReadWrite {
+		t.Errorf("Scan() = %v, want %v", got, ReadWrite)
+	}
+
+	if err := got.SQLScanner().Scan(int64(8)); err == nil {
+		t.Error("Scan() of an undefined value should return an error")
+	}
+
+	// Perm is a uint8, so these would wrap around to Read.
+	for _, v := range []interface{}{int64(257), int64(-255), []byte("257")} {
+		if err := got.SQLScanner().Scan(v); err == nil {
+			t.Errorf("Scan(%v) of an out of range value should return an error", v)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Code generated by "go-enumerator --transform=snake --trim-prefix=Shade" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// shadeNames holds the name of every Shade value.
+const shadeNames = "lightdark_bluehtml_gray"
+
+// shadeNameIndex holds the offsets of each Shade value in shadeNames.
+var shadeNameIndex = [...]uint8{0, 5, 14, 23}
+
+// shadeNameIndexOf returns the position of s in shadeNameIndex, or -1 if !s.Defined().
+func shadeNameIndexOf(s Shade) int {
+	if s < 0 || s > 2 {
+		return -1
+	}
+	return int(s)
+}
+
+// String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
+func (s Shade) String() string {
+	if i := shadeNameIndexOf(s); i >= 0 {
+		return shadeNames[shadeNameIndex[i]:shadeNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Shade(%d)", s)
+}
+
+// Bytes returns a byte-level representation of String(). If !s.Defined(), then a generated string is returned based on s's value.
+func (s Shade) Bytes() []byte {
+	if i := shadeNameIndexOf(s); i >= 0 {
+		return append([]byte(nil), shadeNames[shadeNameIndex[i]:shadeNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Shade(%d)", s))
+}
+
+// Defined returns true if s holds a defined value.
+func (s Shade) Defined() bool {
+	switch s {
+	case 0, 1, 2:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidShade is matched by the errors returned when a Shade cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidShade = errors.New("invalid Shade")
+
+// InvalidShadeError is the error returned when a string is not the name of a Shade.
+// It matches ErrInvalidShade with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidShadeError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidShadeError) Error() string {
+	msg := fmt.Sprintf("invalid Shade %q: must be one of light, dark_blue or html_gray", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidShadeError) Suggestions() []string {
+	return shadeClosestNames(e.Input, []string{"light", "dark_blue", "html_gray"})
+}
+
+// Is returns true if target is ErrInvalidShade.
+func (e *InvalidShadeError) Is(target error) bool {
+	return target == ErrInvalidShade
+}
+
+// ParseShade returns the Shade named s. An error is returned if s is not the name of a Shade.
+func ParseShade(s string) (Shade, error) {
+	switch s {
+	case "light":
+		return ShadeLight, nil
+	case "dark_blue":
+		return ShadeDarkBlue, nil
+	case "html_gray":
+		return ShadeHTMLGray, nil
+	default:
+		return 0, &InvalidShadeError{Input: s}
+	}
+}
+
+// MustParseShade is like ParseShade, but panics if s cannot be parsed.
+func MustParseShade(s string) Shade {
+	x, err := ParseShade(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Shade values
+func (s *Shade) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseShade(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined Shade. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := Shade(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == Shade(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use ShadeValues() to loop through the values in the order they are declared.
+func (s Shade) Next() Shade {
+	switch s {
+	case ShadeLight:
+		return ShadeDarkBlue
+	case ShadeDarkBlue:
+		return ShadeHTMLGray
+	case ShadeHTMLGray:
+		return ShadeLight
+	default:
+		return ShadeLight
+	}
+}
+
+// ShadeLen is the number of defined Shade values.
+const ShadeLen = 3
+
+// ShadeValues returns all defined Shade values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func ShadeValues() []Shade {
+	return []Shade{ShadeLight, ShadeDarkBlue, ShadeHTMLGray}
+}
+
+// ShadeNames returns the names of all defined Shade values in the same order as ShadeValues.
+// A new slice is returned on every call, so it is safe to modify.
+func ShadeNames() []string {
+	return []string{"light", "dark_blue", "html_gray"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[ShadeLight-0]
+	_ = x[ShadeDarkBlue-1]
+	_ = x[ShadeHTMLGray-2]
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Shade) MarshalJSON() ([]byte, error) {
+	if i := shadeNameIndexOf(s); i >= 0 {
+		name := shadeNames[shadeNameIndex[i]:shadeNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Shade) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseShade(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Shade) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Shade) UnmarshalText(x []byte) error {
+	y, err := ParseShade(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// shadeClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func shadeClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,90 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestShade_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Shade
+		want string
+	}{
+		{
+			"ShadeLight",
+			ShadeLight,
+			"light",
+		},
+		{
+			"ShadeDarkBlue",
+			ShadeDarkBlue,
+			"dark_blue",
+		},
+		{
+			"ShadeHTMLGray",
+			ShadeHTMLGray,
+			"html_gray",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShade_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Shade
+		wantErr bool
+	}{
+		{
+			"dark_blue",
+			"dark_blue",
+			ShadeDarkBlue,
+			false,
+		},
+		{
+			"Go name",
+			"ShadeDarkBlue",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Shade
+			_, err := fmt.Sscan(tt.input, &got)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShade_JSON(t *testing.T) {
+	data, err := json.Marshal([]Shade{ShadeLight, ShadeHTMLGray})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `["light","html_gray"]` {
+		t.Errorf("MarshalJSON() = %s, want %s", data, `["light","html_gray"]`)
+	}
+
+	var got []Shade
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != ShadeLight || got[1] != ShadeHTMLGray {
+		t.Errorf("UnmarshalJSON() = %v", got)
+	}
+}
@@ -0,0 +1,266 @@
+// Code generated by "go-enumerator --fuzz --tests" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// signalNames holds the name of every Signal value.
+const signalNames = "SignalHangupSignalInterruptSignalKillSignalTerminate"
+
+// signalNameIndex holds the offsets of each Signal value in signalNames.
+var signalNameIndex = [...]uint8{0, 12, 27, 37, 52}
+
+// signalNameOrdinals maps each Signal value to its position in signalNameIndex.
+var signalNameOrdinals = map[Signal]int{
+	SignalHangup:    0,
+	SignalInterrupt: 1,
+	SignalKill:      2,
+	SignalTerminate: 3,
+}
+
+// signalNameIndexOf returns the position of s in signalNameIndex, or -1 if !s.Defined().
+func signalNameIndexOf(s Signal) int {
+	if i, ok := signalNameOrdinals[s]; ok {
+		return i
+	}
+	return -1
+}
+
+// String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
+func (s Signal) String() string {
+	if i := signalNameIndexOf(s); i >= 0 {
+		return signalNames[signalNameIndex[i]:signalNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Signal(%d)", s)
+}
+
+// Bytes returns a byte-level representation of String(). If !s.Defined(), then a generated string is returned based on s's value.
+func (s Signal) Bytes() []byte {
+	if i := signalNameIndexOf(s); i >= 0 {
+		return append([]byte(nil), signalNames[signalNameIndex[i]:signalNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Signal(%d)", s))
+}
+
+// Defined returns true if s holds a defined value.
+func (s Signal) Defined() bool {
+	switch s {
+	case 1, 2, 9, 15:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidSignal is matched by the errors returned when a Signal cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidSignal = errors.New("invalid Signal")
+
+// InvalidSignalError is the error returned when a string is not the name of a Signal.
+// It matches ErrInvalidSignal with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidSignalError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidSignalError) Error() string {
+	msg := fmt.Sprintf("invalid Signal %q: must be one of SignalHangup, SignalInterrupt, SignalKill or SignalTerminate", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidSignalError) Suggestions() []string {
+	return signalClosestNames(e.Input, []string{"SignalHangup", "SignalInterrupt", "SignalKill", "SignalTerminate"})
+}
+
+// Is returns true if target is ErrInvalidSignal.
+func (e *InvalidSignalError) Is(target error) bool {
+	return target == ErrInvalidSignal
+}
+
+// ParseSignal returns the Signal named s. An error is returned if s is not the name of a Signal.
+func ParseSignal(s string) (Signal, error) {
+	switch s {
+	case "SignalHangup":
+		return SignalHangup, nil
+	case "SignalInterrupt":
+		return SignalInterrupt, nil
+	case "SignalKill":
+		return SignalKill, nil
+	case "SignalTerminate":
+		return SignalTerminate, nil
+	default:
+		return 0, &InvalidSignalError{Input: s}
+	}
+}
+
+// MustParseSignal is like ParseSignal, but panics if s cannot be parsed.
+func MustParseSignal(s string) Signal {
+	x, err := ParseSignal(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Signal values
+func (s *Signal) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseSignal(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined Signal. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := Signal(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == Signal(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use SignalValues() to loop through the values in the order they are declared.
+func (s Signal) Next() Signal {
+	switch s {
+	case SignalHangup:
+		return SignalInterrupt
+	case SignalInterrupt:
+		return SignalKill
+	case SignalKill:
+		return SignalTerminate
+	case SignalTerminate:
+		return SignalHangup
+	default:
+		return SignalHangup
+	}
+}
+
+// SignalLen is the number of defined Signal values.
+const SignalLen = 4
+
+// SignalValues returns all defined Signal values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func SignalValues() []Signal {
+	return []Signal{SignalHangup, SignalInterrupt, SignalKill, SignalTerminate}
+}
+
+// SignalNames returns the names of all defined Signal values in the same order as SignalValues.
+// A new slice is returned on every call, so it is safe to modify.
+func SignalNames() []string {
+	return []string{"SignalHangup", "SignalInterrupt", "SignalKill", "SignalTerminate"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[SignalHangup-1]
+	_ = x[SignalInterrupt-2]
+	_ = x[SignalKill-9]
+	_ = x[SignalTerminate-15]
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Signal) MarshalJSON() ([]byte, error) {
+	if i := signalNameIndexOf(s); i >= 0 {
+		name := signalNames[signalNameIndex[i]:signalNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Signal) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseSignal(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Signal) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Signal) UnmarshalText(x []byte) error {
+	y, err := ParseSignal(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// signalClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func signalClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
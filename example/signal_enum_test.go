@@ -0,0 +1,150 @@
+// Code generated by "go-enumerator --fuzz --tests" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestSignal_StringScanRoundTrip(t *testing.T) {
+	for _, x := range SignalValues() {
+		var y Signal
+		_, err := fmt.Sscan(x.String(), &y)
+		if err != nil {
+			t.Errorf("scanning %q failed: %v", x.String(), err)
+		} else if y != x {
+			t.Errorf("scanning %q = %v, want %v", x.String(), y, x)
+		}
+	}
+}
+
+func TestSignal_JSONRoundTrip(t *testing.T) {
+	for _, x := range SignalValues() {
+		b, err := json.Marshal(x)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", x, err)
+			continue
+		}
+
+		var y Signal
+		if err := json.Unmarshal(b, &y); err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", b, err)
+		} else if y != x {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", b, y, x)
+		}
+	}
+}
+
+func TestSignal_DefinedValues(t *testing.T) {
+	for _, x := range SignalValues() {
+		if !x.Defined() {
+			t.Errorf("%v.Defined() = false, want true", x)
+		}
+	}
+
+	if x := Signal(0); x.Defined() {
+		t.Errorf("%v.Defined() = true, want false", x)
+	}
+}
+
+func TestSignal_NextVisitsAll(t *testing.T) {
+	first := SignalValues()[0]
+	seen := make(map[Signal]bool)
+	for x, i := first, 0; i < SignalLen; x, i = x.Next(), i+1 {
+		if seen[x] {
+			t.Fatalf("Next() visited %v twice", x)
+		}
+		seen[x] = true
+	}
+
+	for _, x := range SignalValues() {
+		if !seen[x] {
+			t.Errorf("Next() did not visit %v", x)
+		}
+	}
+}
+
+func FuzzParseSignal(f *testing.F) {
+	f.Add("SignalHangup")
+	f.Add("SignalInterrupt")
+	f.Add("SignalKill")
+	f.Add("SignalTerminate")
+	f.Add("")
+	f.Add(" ")
+	f.Add("|")
+	f.Add("\x00")
+	f.Add("\xff")
+	f.Add("SignalHangup ")
+	f.Add(" SignalHangup")
+	f.Add("SignalHangup|")
+	f.Add("|SignalHangup")
+	f.Add("signalhangup")
+	f.Add("SIGNALHANGUP")
+	f.Add("SignalHangu")
+	f.Add("SignalHangupSignalHangup")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := ParseSignal(s)
+		if err != nil {
+			return
+		}
+
+		if !x.Defined() {
+			t.Errorf("ParseSignal(%q) = %v, which is not defined", s, x)
+		}
+
+		if y, err := ParseSignal(x.String()); err != nil || y != x {
+			t.Errorf("ParseSignal(%q) = %v, %v, want %v", x.String(), y, err, x)
+		}
+	})
+}
+
+func FuzzUnmarshalJSONSignal(f *testing.F) {
+	f.Add([]byte("\"SignalHangup\""))
+	f.Add([]byte("\"SignalInterrupt\""))
+	f.Add([]byte("\"SignalKill\""))
+	f.Add([]byte("\"SignalTerminate\""))
+	f.Add([]byte("\"\""))
+	f.Add([]byte("\" \""))
+	f.Add([]byte("\"|\""))
+	f.Add([]byte("\"\x00\""))
+	f.Add([]byte("\"\xff\""))
+	f.Add([]byte("\"SignalHangup \""))
+	f.Add([]byte("\" SignalHangup\""))
+	f.Add([]byte("\"SignalHangup|\""))
+	f.Add([]byte("\"|SignalHangup\""))
+	f.Add([]byte("\"signalhangup\""))
+	f.Add([]byte("\"SIGNALHANGUP\""))
+	f.Add([]byte("\"SignalHangu\""))
+	f.Add([]byte("\"SignalHangupSignalHangup\""))
+	f.Add([]byte(""))
+	f.Add([]byte("\""))
+	f.Add([]byte("null"))
+	f.Add([]byte("0"))
+	f.Add([]byte("{}"))
+	f.Add([]byte("[]"))
+	f.Add([]byte("\"\\u0000\""))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var x Signal
+		if err := x.UnmarshalJSON(b); err != nil {
+			return
+		}
+
+		if !x.Defined() {
+			t.Errorf("UnmarshalJSON(%q) = %v, which is not defined", b, x)
+		}
+
+		c, err := x.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON() failed for %v: %v", x, err)
+		}
+
+		var y Signal
+		if err := y.UnmarshalJSON(c); err != nil || y != x {
+			t.Errorf("UnmarshalJSON(%q) = %v, %v, want %v", c, y, err, x)
+		}
+	})
+}
@@ -0,0 +1,299 @@
+// Code generated by "go-enumerator --sql=value" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
+func (s Speed) String() string {
+	switch s {
+	case SpeedSlow:
+		return "SpeedSlow"
+	case SpeedNormal:
+		return "SpeedNormal"
+	case SpeedFast:
+		return "SpeedFast"
+	}
+	return fmt.Sprintf("Speed(%g)", s)
+}
+
+// Bytes returns a byte-level representation of String(). If !s.Defined(), then a generated string is returned based on s's value.
+func (s Speed) Bytes() []byte {
+	switch s {
+	case SpeedSlow:
+		return []byte{'S', 'p', 'e', 'e', 'd', 'S', 'l', 'o', 'w'}
+	case SpeedNormal:
+		return []byte{'S', 'p', 'e', 'e', 'd', 'N', 'o', 'r', 'm', 'a', 'l'}
+	case SpeedFast:
+		return []byte{'S', 'p', 'e', 'e', 'd', 'F', 'a', 's', 't'}
+	}
+	return []byte(fmt.Sprintf("Speed(%g)", s))
+}
+
+// Defined returns true if s holds a defined value.
+func (s Speed) Defined() bool {
+	switch s {
+	case 0.5, 1, 1.5:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidSpeed is matched by the errors returned when a Speed cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidSpeed = errors.New("invalid Speed")
+
+// InvalidSpeedError is the error returned when a string is not the name of a Speed.
+// It matches ErrInvalidSpeed with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidSpeedError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidSpeedError) Error() string {
+	msg := fmt.Sprintf("invalid Speed %q: must be one of SpeedSlow, SpeedNormal or SpeedFast", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidSpeedError) Suggestions() []string {
+	return speedClosestNames(e.Input, []string{"SpeedSlow", "SpeedNormal", "SpeedFast"})
+}
+
+// Is returns true if target is ErrInvalidSpeed.
+func (e *InvalidSpeedError) Is(target error) bool {
+	return target == ErrInvalidSpeed
+}
+
+// ParseSpeed returns the Speed named s. An error is returned if s is not the name of a Speed.
+func ParseSpeed(s string) (Speed, error) {
+	switch s {
+	case "SpeedSlow":
+		return SpeedSlow, nil
+	case "SpeedNormal":
+		return SpeedNormal, nil
+	case "SpeedFast":
+		return SpeedFast, nil
+	default:
+		return 0, &InvalidSpeedError{Input: s}
+	}
+}
+
+// MustParseSpeed is like ParseSpeed, but panics if s cannot be parsed.
+func MustParseSpeed(s string) Speed {
+	x, err := ParseSpeed(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Speed values
+func (s *Speed) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseSpeed(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined Speed. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := Speed(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == Speed(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use SpeedValues() to loop through the values in the order they are declared.
+func (s Speed) Next() Speed {
+	switch s {
+	case SpeedSlow:
+		return SpeedNormal
+	case SpeedNormal:
+		return SpeedFast
+	case SpeedFast:
+		return SpeedSlow
+	default:
+		return SpeedSlow
+	}
+}
+
+// SpeedLen is the number of defined Speed values.
+const SpeedLen = 3
+
+// SpeedValues returns all defined Speed values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func SpeedValues() []Speed {
+	return []Speed{SpeedSlow, SpeedNormal, SpeedFast}
+}
+
+// SpeedNames returns the names of all defined Speed values in the same order as SpeedValues.
+// A new slice is returned on every call, so it is safe to modify.
+func SpeedNames() []string {
+	return []string{"SpeedSlow", "SpeedNormal", "SpeedFast"}
+}
+
+func _() {
+	var x [1]struct{}
+	// A "constant truncated to integer", "cannot convert" or "invalid array index" compiler error
+	// signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[int(SpeedSlow-0.5)]
+	_ = x[int(SpeedNormal-1)]
+	_ = x[int(SpeedFast-1.5)]
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Speed) MarshalJSON() ([]byte, error) {
+	x := s.Bytes()
+	y := make([]byte, 0, len(x))
+	return append(append(append(y, '"'), x...), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Speed) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseSpeed(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Speed) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Speed) UnmarshalText(x []byte) error {
+	y, err := ParseSpeed(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// Value implements driver.Valuer. The underlying value of s is stored. An error is returned if !s.Defined().
+func (s Speed) Value() (driver.Value, error) {
+	if !s.Defined() {
+		return nil, fmt.Errorf("undefined Speed value: %v", s)
+	}
+	return float64(s), nil
+}
+
+// SQLScanner returns a sql.Scanner that stores the values it scans in s.
+// Speed implements fmt.Scanner, so it cannot implement sql.Scanner directly.
+//
+//	err := row.Scan(s.SQLScanner())
+func (s *Speed) SQLScanner() sql.Scanner {
+	return (*speedSQLScanner)(s)
+}
+
+// speedSQLScanner implements sql.Scanner for Speed.
+type speedSQLScanner Speed
+
+// Scan implements sql.Scanner. string, []byte and float64 values are accepted.
+func (s *speedSQLScanner) Scan(x interface{}) error {
+	var y Speed
+	switch x := x.(type) {
+	case string:
+		v, err := strconv.ParseFloat(x, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse value %q into Speed: %w", x, err)
+		}
+		y = Speed(v)
+	case []byte:
+		v, err := strconv.ParseFloat(string(x), 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse value %q into Speed: %w", string(x), err)
+		}
+		y = Speed(v)
+	case float64:
+		y = Speed(x)
+	default:
+		return fmt.Errorf("cannot scan %T into Speed", x)
+	}
+
+	if !y.Defined() {
+		return fmt.Errorf("undefined Speed value: %v", y)
+	}
+	*s = speedSQLScanner(y)
+	return nil
+}
+
+// speedClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func speedClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,130 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestSpeed_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Speed
+		want string
+	}{
+		{
+			"SpeedSlow",
+			SpeedSlow,
+			"SpeedSlow",
+		},
+		{
+			"SpeedFast",
+			SpeedFast,
+			"SpeedFast",
+		},
+		{
+			"undefined",
+			0.75,
+			"Speed(0.75)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := string(tt.e.Bytes()); got != tt.want {
+				t.Errorf("Bytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeed_Defined(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Speed
+		want bool
+	}{
+		{
+			"SpeedSlow",
+			SpeedSlow,
+			true,
+		},
+		{
+			"SpeedNormal",
+			SpeedNormal,
+			true,
+		},
+		{
+			"between values",
+			1.25,
+			false,
+		},
+		{
+			"zero",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Defined(); got != tt.want {
+				t.Errorf("Defined() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeed_JSON(t *testing.T) {
+	data, err := json.Marshal(SpeedFast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"SpeedFast"` {
+		t.Errorf("MarshalJSON() = %s, want %s", data, `"SpeedFast"`)
+	}
+
+	var got Speed
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != SpeedFast {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, SpeedFast)
+	}
+}
+
+func TestSpeed_SQL(t *testing.T) {
+	v, err := SpeedSlow.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 0.5 {
+		t.Errorf("Value() = %v, want %v", v, 0.5)
+	}
+
+	var got Speed
+	if err := got.SQLScanner().Scan([]byte("1.5")); err != nil {
+		t.Fatal(err)
+	}
+	if got != SpeedFast {
+		t.Errorf("Scan() = %v, want %v", got, SpeedFast)
+	}
+
+	if err := got.SQLScanner().Scan(0.75); err == nil {
+		t.Error("Scan() of an undefined value should return an error")
+	}
+}
+
+func ExampleSpeed_Next() {
+	s := SpeedSlow
+	for i := 0; i < 3; i++ {
+		fmt.Println(s)
+		s = s.Next()
+	}
+
+	// Output:
+	// SpeedSlow
+	// SpeedNormal
+	// SpeedFast
+}
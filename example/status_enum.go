@@ -0,0 +1,253 @@
+// Code generated by "go-enumerator --ignore-case" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// statusNames holds the name of every Status value.
+const statusNames = "okin-progressStatusFailed"
+
+// statusNameIndex holds the offsets of each Status value in statusNames.
+var statusNameIndex = [...]uint8{0, 2, 13, 25}
+
+// statusNameIndexOf returns the position of s in statusNameIndex, or -1 if !s.Defined().
+func statusNameIndexOf(s Status) int {
+	if s < 0 || s > 2 {
+		return -1
+	}
+	return int(s)
+}
+
+// String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
+func (s Status) String() string {
+	if i := statusNameIndexOf(s); i >= 0 {
+		return statusNames[statusNameIndex[i]:statusNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Status(%d)", s)
+}
+
+// Bytes returns a byte-level representation of String(). If !s.Defined(), then a generated string is returned based on s's value.
+func (s Status) Bytes() []byte {
+	if i := statusNameIndexOf(s); i >= 0 {
+		return append([]byte(nil), statusNames[statusNameIndex[i]:statusNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Status(%d)", s))
+}
+
+// Defined returns true if s holds a defined value.
+func (s Status) Defined() bool {
+	switch s {
+	case 0, 1, 2:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidStatus is matched by the errors returned when a Status cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidStatus = errors.New("invalid Status")
+
+// InvalidStatusError is the error returned when a string is not the name of a Status.
+// It matches ErrInvalidStatus with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidStatusError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidStatusError) Error() string {
+	msg := fmt.Sprintf("invalid Status %q: must be one of ok, in-progress or StatusFailed", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidStatusError) Suggestions() []string {
+	return statusClosestNames(e.Input, []string{"ok", "in-progress", "pending", "started", "StatusFailed"})
+}
+
+// Is returns true if target is ErrInvalidStatus.
+func (e *InvalidStatusError) Is(target error) bool {
+	return target == ErrInvalidStatus
+}
+
+// ParseStatus returns the Status named s. An error is returned if s is not the name of a Status.
+func ParseStatus(s string) (Status, error) {
+	switch strings.ToLower(s) {
+	case "ok":
+		return StatusOK, nil
+	case "in-progress", "pending", "started":
+		return StatusInProgress, nil
+	case "statusfailed":
+		return StatusFailed, nil
+	default:
+		return 0, &InvalidStatusError{Input: s}
+	}
+}
+
+// MustParseStatus is like ParseStatus, but panics if s cannot be parsed.
+func MustParseStatus(s string) Status {
+	x, err := ParseStatus(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Status values
+func (s *Status) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseStatus(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined Status. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := Status(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == Status(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use StatusValues() to loop through the values in the order they are declared.
+func (s Status) Next() Status {
+	switch s {
+	case StatusOK:
+		return StatusInProgress
+	case StatusInProgress:
+		return StatusFailed
+	case StatusFailed:
+		return StatusOK
+	default:
+		return StatusOK
+	}
+}
+
+// StatusLen is the number of defined Status values.
+const StatusLen = 3
+
+// StatusValues returns all defined Status values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func StatusValues() []Status {
+	return []Status{StatusOK, StatusInProgress, StatusFailed}
+}
+
+// StatusNames returns the names of all defined Status values in the same order as StatusValues.
+// A new slice is returned on every call, so it is safe to modify.
+func StatusNames() []string {
+	return []string{"ok", "in-progress", "StatusFailed"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[StatusOK-0]
+	_ = x[StatusInProgress-1]
+	_ = x[StatusFailed-2]
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Status) MarshalJSON() ([]byte, error) {
+	if i := statusNameIndexOf(s); i >= 0 {
+		name := statusNames[statusNameIndex[i]:statusNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Status) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseStatus(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Status) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Status) UnmarshalText(x []byte) error {
+	y, err := ParseStatus(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// statusClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func statusClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
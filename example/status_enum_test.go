@@ -0,0 +1,163 @@
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStatus_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Status
+		want string
+	}{
+		{
+			"StatusOK",
+			StatusOK,
+			"ok",
+		},
+		{
+			"StatusInProgress",
+			StatusInProgress,
+			"in-progress",
+		},
+		{
+			"StatusFailed",
+			StatusFailed,
+			"StatusFailed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatus_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Status
+		wantErr bool
+	}{
+		{
+			"ok",
+			"ok",
+			StatusOK,
+			false,
+		},
+		{
+			"in-progress",
+			"in-progress",
+			StatusInProgress,
+			false,
+		},
+		{
+			"OK",
+			"OK",
+			StatusOK,
+			false,
+		},
+		{
+			"IN-PROGRESS",
+			"IN-PROGRESS",
+			StatusInProgress,
+			false,
+		},
+		{
+			"alias",
+			"Pending",
+			StatusInProgress,
+			false,
+		},
+		{
+			"Go name",
+			"StatusOK",
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Status
+			_, err := fmt.Sscan(tt.input, &got)
+			if tt.wantErr != (err != nil) {
+				t.Error(err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatus_UnmarshalJSON(t *testing.T) {
+	var got Status
+	if err := json.Unmarshal([]byte(`"Started"`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != StatusInProgress {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, StatusInProgress)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"in-progress"` {
+		t.Errorf("MarshalJSON() = %s, want %s", data, `"in-progress"`)
+	}
+}
+
+func TestStatus_Suggestions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			"typo",
+			"in-progres",
+			[]string{"in-progress"},
+		},
+		{
+			"alias",
+			"pendng",
+			[]string{"pending"},
+		},
+		{
+			"transposed",
+			"StatusFialed",
+			[]string{"StatusFailed"},
+		},
+		{
+			"unrelated",
+			"cancelled",
+			nil,
+		},
+		{
+			"empty",
+			"",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseStatus(tt.input)
+
+			var target *InvalidStatusError
+			if !errors.As(err, &target) {
+				t.Fatalf("errors.As(%v) = false, want true", err)
+			}
+			if got := target.Suggestions(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggestions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
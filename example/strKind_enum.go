@@ -1,8 +1,13 @@
-// Code generated by "go-enumerator"; DO NOT EDIT.
+// Code generated by "go-enumerator --fuzz --tests" (devel); DO NOT EDIT.
 
 package example
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
 func (s StrKind) String() string {
@@ -24,6 +29,58 @@ func (s StrKind) Defined() bool {
 	}
 }
 
+// ErrInvalidStrKind is matched by the errors returned when a StrKind cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidStrKind = errors.New("invalid StrKind")
+
+// InvalidStrKindError is the error returned when a string is not the name of a StrKind.
+// It matches ErrInvalidStrKind with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidStrKindError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidStrKindError) Error() string {
+	msg := fmt.Sprintf("invalid StrKind %q: must be one of Hello or World", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidStrKindError) Suggestions() []string {
+	return strKindClosestNames(e.Input, []string{"Hello", "World"})
+}
+
+// Is returns true if target is ErrInvalidStrKind.
+func (e *InvalidStrKindError) Is(target error) bool {
+	return target == ErrInvalidStrKind
+}
+
+// ParseStrKind returns the StrKind named s. An error is returned if s is not the name of a StrKind.
+func ParseStrKind(s string) (StrKind, error) {
+	switch s {
+	case "Hello":
+		return Hello, nil
+	case "World":
+		return World, nil
+	default:
+		return "", &InvalidStrKindError{Input: s}
+	}
+}
+
+// MustParseStrKind is like ParseStrKind, but panics if s cannot be parsed.
+func MustParseStrKind(s string) StrKind {
+	x, err := ParseStrKind(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
 // Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into StrKind values
 func (s *StrKind) Scan(scanState fmt.ScanState, verb rune) error {
 	token, err := scanState.Token(true, nil)
@@ -31,14 +88,11 @@ func (s *StrKind) Scan(scanState fmt.ScanState, verb rune) error {
 		return err
 	}
 
-	switch string(token) {
-	case "Hello":
-		*s = Hello
-	case "World":
-		*s = World
-	default:
-		return fmt.Errorf("unknown StrKind value: %s", token)
+	x, err := ParseStrKind(string(token))
+	if err != nil {
+		return err
 	}
+	*s = x
 	return nil
 }
 
@@ -55,6 +109,7 @@ func (s *StrKind) Scan(scanState fmt.ScanState, verb rune) error {
 //	}
 //
 // The exact order that values are returned when looping should not be relied upon.
+// Use StrKindValues() to loop through the values in the order they are declared.
 func (s StrKind) Next() StrKind {
 	switch s {
 	case Hello:
@@ -66,6 +121,21 @@ func (s StrKind) Next() StrKind {
 	}
 }
 
+// StrKindLen is the number of defined StrKind values.
+const StrKindLen = 2
+
+// StrKindValues returns all defined StrKind values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func StrKindValues() []StrKind {
+	return []StrKind{Hello, World}
+}
+
+// StrKindNames returns the names of all defined StrKind values in the same order as StrKindValues.
+// A new slice is returned on every call, so it is safe to modify.
+func StrKindNames() []string {
+	return []string{"Hello", "World"}
+}
+
 func _() {
 	var x [1]struct{}
 	// An "invalid array index" compiler error signifies that the constant values have changed.
@@ -88,21 +158,79 @@ func _() {
 
 // MarshalJSON implements json.Marshaler
 func (s StrKind) MarshalJSON() ([]byte, error) {
-	x := s.Bytes()
-	y := make([]byte, 0, len(x))
-	return append(append(append(y, '"'), x...), '"'), nil
+	return json.Marshal(string(s))
 }
 
 // UnmarshalJSON implements json.Unmarshaler
 func (s *StrKind) UnmarshalJSON(x []byte) error {
-	switch string(x) {
-	case "\"Hello\"":
-		*s = Hello
-		return nil
-	case "\"World\"":
-		*s = World
-		return nil
-	default:
-		return fmt.Errorf("failed to parse value %v into %T", x, *s)
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseStrKind(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s StrKind) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *StrKind) UnmarshalText(x []byte) error {
+	y, err := ParseStrKind(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// strKindClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func strKindClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
 	}
+	return ret
 }
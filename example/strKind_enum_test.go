@@ -0,0 +1,146 @@
+// Code generated by "go-enumerator --fuzz --tests" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestStrKind_StringScanRoundTrip(t *testing.T) {
+	for _, x := range StrKindValues() {
+		var y StrKind
+		_, err := fmt.Sscan(x.String(), &y)
+		if err != nil {
+			t.Errorf("scanning %q failed: %v", x.String(), err)
+		} else if y != x {
+			t.Errorf("scanning %q = %v, want %v", x.String(), y, x)
+		}
+	}
+}
+
+func TestStrKind_JSONRoundTrip(t *testing.T) {
+	for _, x := range StrKindValues() {
+		b, err := json.Marshal(x)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", x, err)
+			continue
+		}
+
+		var y StrKind
+		if err := json.Unmarshal(b, &y); err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", b, err)
+		} else if y != x {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", b, y, x)
+		}
+	}
+}
+
+func TestStrKind_DefinedValues(t *testing.T) {
+	for _, x := range StrKindValues() {
+		if !x.Defined() {
+			t.Errorf("%v.Defined() = false, want true", x)
+		}
+	}
+
+	if x := StrKind("undefined"); x.Defined() {
+		t.Errorf("%v.Defined() = true, want false", x)
+	}
+}
+
+func TestStrKind_NextVisitsAll(t *testing.T) {
+	first := StrKindValues()[0]
+	seen := make(map[StrKind]bool)
+	for x, i := first, 0; i < StrKindLen; x, i = x.Next(), i+1 {
+		if seen[x] {
+			t.Fatalf("Next() visited %v twice", x)
+		}
+		seen[x] = true
+	}
+
+	for _, x := range StrKindValues() {
+		if !seen[x] {
+			t.Errorf("Next() did not visit %v", x)
+		}
+	}
+}
+
+func FuzzParseStrKind(f *testing.F) {
+	f.Add("Hello")
+	f.Add("World")
+	f.Add("")
+	f.Add(" ")
+	f.Add("|")
+	f.Add("\x00")
+	f.Add("\xff")
+	f.Add("Hello ")
+	f.Add(" Hello")
+	f.Add("Hello|")
+	f.Add("|Hello")
+	f.Add("hello")
+	f.Add("HELLO")
+	f.Add("Hell")
+	f.Add("HelloHello")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := ParseStrKind(s)
+		if err != nil {
+			return
+		}
+
+		if !x.Defined() {
+			t.Errorf("ParseStrKind(%q) = %v, which is not defined", s, x)
+		}
+
+		if y, err := ParseStrKind(x.String()); err != nil || y != x {
+			t.Errorf("ParseStrKind(%q) = %v, %v, want %v", x.String(), y, err, x)
+		}
+	})
+}
+
+func FuzzUnmarshalJSONStrKind(f *testing.F) {
+	f.Add([]byte("\"Hello\""))
+	f.Add([]byte("\"World\""))
+	f.Add([]byte("\"\""))
+	f.Add([]byte("\" \""))
+	f.Add([]byte("\"|\""))
+	f.Add([]byte("\"\x00\""))
+	f.Add([]byte("\"\xff\""))
+	f.Add([]byte("\"Hello \""))
+	f.Add([]byte("\" Hello\""))
+	f.Add([]byte("\"Hello|\""))
+	f.Add([]byte("\"|Hello\""))
+	f.Add([]byte("\"hello\""))
+	f.Add([]byte("\"HELLO\""))
+	f.Add([]byte("\"Hell\""))
+	f.Add([]byte("\"HelloHello\""))
+	f.Add([]byte(""))
+	f.Add([]byte("\""))
+	f.Add([]byte("null"))
+	f.Add([]byte("0"))
+	f.Add([]byte("{}"))
+	f.Add([]byte("[]"))
+	f.Add([]byte("\"\\u0000\""))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var x StrKind
+		if err := x.UnmarshalJSON(b); err != nil {
+			return
+		}
+
+		if !x.Defined() {
+			t.Errorf("UnmarshalJSON(%q) = %v, which is not defined", b, x)
+		}
+
+		c, err := x.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON() failed for %v: %v", x, err)
+		}
+
+		var y StrKind
+		if err := y.UnmarshalJSON(c); err != nil || y != x {
+			t.Errorf("UnmarshalJSON(%q) = %v, %v, want %v", c, y, err, x)
+		}
+	})
+}
@@ -0,0 +1,193 @@
+// Code generated by "go-enumerator --skip=json,text" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Bytes returns a byte-level representation of String().
+func (s Suit) Bytes() []byte {
+	return []byte(s.String())
+}
+
+// Defined returns true if s holds a defined value.
+func (s Suit) Defined() bool {
+	switch s {
+	case 0, 1, 2, 3:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidSuit is matched by the errors returned when a Suit cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidSuit = errors.New("invalid Suit")
+
+// InvalidSuitError is the error returned when a string is not the name of a Suit.
+// It matches ErrInvalidSuit with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidSuitError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidSuitError) Error() string {
+	msg := fmt.Sprintf("invalid Suit %q: must be one of Spades, Hearts, Diamonds or Clubs", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidSuitError) Suggestions() []string {
+	return suitClosestNames(e.Input, []string{"Spades", "Hearts", "Diamonds", "Clubs"})
+}
+
+// Is returns true if target is ErrInvalidSuit.
+func (e *InvalidSuitError) Is(target error) bool {
+	return target == ErrInvalidSuit
+}
+
+// ParseSuit returns the Suit named s. An error is returned if s is not the name of a Suit.
+func ParseSuit(s string) (Suit, error) {
+	switch s {
+	case "Spades":
+		return Spades, nil
+	case "Hearts":
+		return Hearts, nil
+	case "Diamonds":
+		return Diamonds, nil
+	case "Clubs":
+		return Clubs, nil
+	default:
+		return 0, &InvalidSuitError{Input: s}
+	}
+}
+
+// MustParseSuit is like ParseSuit, but panics if s cannot be parsed.
+func MustParseSuit(s string) Suit {
+	x, err := ParseSuit(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Suit values
+func (s *Suit) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseSuit(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined Suit. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := Suit(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == Suit(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use SuitValues() to loop through the values in the order they are declared.
+func (s Suit) Next() Suit {
+	switch s {
+	case Spades:
+		return Hearts
+	case Hearts:
+		return Diamonds
+	case Diamonds:
+		return Clubs
+	case Clubs:
+		return Spades
+	default:
+		return Spades
+	}
+}
+
+// SuitLen is the number of defined Suit values.
+const SuitLen = 4
+
+// SuitValues returns all defined Suit values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func SuitValues() []Suit {
+	return []Suit{Spades, Hearts, Diamonds, Clubs}
+}
+
+// SuitNames returns the names of all defined Suit values in the same order as SuitValues.
+// A new slice is returned on every call, so it is safe to modify.
+func SuitNames() []string {
+	return []string{"Spades", "Hearts", "Diamonds", "Clubs"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[Spades-0]
+	_ = x[Hearts-1]
+	_ = x[Diamonds-2]
+	_ = x[Clubs-3]
+}
+
+// suitClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func suitClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
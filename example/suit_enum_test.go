@@ -0,0 +1,74 @@
+package example
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestSuit_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Suit
+		want string
+	}{
+		{
+			"Spades",
+			Spades,
+			"♠",
+		},
+		{
+			"Clubs",
+			Clubs,
+			"♣",
+		},
+		{
+			"undefined",
+			4,
+			"?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := string(tt.e.Bytes()); got != tt.want {
+				t.Errorf("Bytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuit_Scan(t *testing.T) {
+	var got Suit
+	if _, err := fmt.Sscan("Hearts", &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != Hearts {
+		t.Errorf("Scan() = %v, want %v", got, Hearts)
+	}
+}
+
+func TestSuit_Skipped(t *testing.T) {
+	var s interface{} = Spades
+	if _, ok := s.(json.Marshaler); ok {
+		t.Error("Suit implements json.Marshaler, want skipped")
+	}
+	if _, ok := s.(encoding.TextMarshaler); ok {
+		t.Error("Suit implements encoding.TextMarshaler, want skipped")
+	}
+}
+
+func ExampleSuit_String() {
+	names := SuitNames()
+	for i, s := range SuitValues() {
+		fmt.Printf("%v %s %s\n", s, s.Bytes(), names[i])
+	}
+	// Output:
+	// ♠ ♠ Spades
+	// ♥ ♥ Hearts
+	// ♦ ♦ Diamonds
+	// ♣ ♣ Clubs
+}
@@ -0,0 +1,283 @@
+// Code generated by "go-enumerator --layout=switch --trim-prefix=Switch" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// String implements fmt.Stringer. If !s.Defined(), then a generated string is returned based on s's value.
+func (s SwitchWeekday) String() string {
+	switch s {
+	case SwitchSunday:
+		return "Sunday"
+	case SwitchMonday:
+		return "Monday"
+	case SwitchTuesday:
+		return "Tuesday"
+	case SwitchWednesday:
+		return "Wednesday"
+	case SwitchThursday:
+		return "Thursday"
+	case SwitchFriday:
+		return "Friday"
+	case SwitchSaturday:
+		return "Saturday"
+	}
+	return fmt.Sprintf("SwitchWeekday(%d)", s)
+}
+
+// Bytes returns a byte-level representation of String(). If !s.Defined(), then a generated string is returned based on s's value.
+func (s SwitchWeekday) Bytes() []byte {
+	switch s {
+	case SwitchSunday:
+		return []byte{'S', 'u', 'n', 'd', 'a', 'y'}
+	case SwitchMonday:
+		return []byte{'M', 'o', 'n', 'd', 'a', 'y'}
+	case SwitchTuesday:
+		return []byte{'T', 'u', 'e', 's', 'd', 'a', 'y'}
+	case SwitchWednesday:
+		return []byte{'W', 'e', 'd', 'n', 'e', 's', 'd', 'a', 'y'}
+	case SwitchThursday:
+		return []byte{'T', 'h', 'u', 'r', 's', 'd', 'a', 'y'}
+	case SwitchFriday:
+		return []byte{'F', 'r', 'i', 'd', 'a', 'y'}
+	case SwitchSaturday:
+		return []byte{'S', 'a', 't', 'u', 'r', 'd', 'a', 'y'}
+	}
+	return []byte(fmt.Sprintf("SwitchWeekday(%d)", s))
+}
+
+// Defined returns true if s holds a defined value.
+func (s SwitchWeekday) Defined() bool {
+	switch s {
+	case 0, 1, 2, 3, 4, 5, 6:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidSwitchWeekday is matched by the errors returned when a SwitchWeekday cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidSwitchWeekday = errors.New("invalid SwitchWeekday")
+
+// InvalidSwitchWeekdayError is the error returned when a string is not the name of a SwitchWeekday.
+// It matches ErrInvalidSwitchWeekday with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidSwitchWeekdayError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidSwitchWeekdayError) Error() string {
+	msg := fmt.Sprintf("invalid SwitchWeekday %q: must be one of Sunday, Monday, Tuesday, Wednesday, Thursday, Friday or Saturday", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidSwitchWeekdayError) Suggestions() []string {
+	return switchWeekdayClosestNames(e.Input, []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"})
+}
+
+// Is returns true if target is ErrInvalidSwitchWeekday.
+func (e *InvalidSwitchWeekdayError) Is(target error) bool {
+	return target == ErrInvalidSwitchWeekday
+}
+
+// ParseSwitchWeekday returns the SwitchWeekday named s. An error is returned if s is not the name of a SwitchWeekday.
+func ParseSwitchWeekday(s string) (SwitchWeekday, error) {
+	switch s {
+	case "Sunday":
+		return SwitchSunday, nil
+	case "Monday":
+		return SwitchMonday, nil
+	case "Tuesday":
+		return SwitchTuesday, nil
+	case "Wednesday":
+		return SwitchWednesday, nil
+	case "Thursday":
+		return SwitchThursday, nil
+	case "Friday":
+		return SwitchFriday, nil
+	case "Saturday":
+		return SwitchSaturday, nil
+	default:
+		return 0, &InvalidSwitchWeekdayError{Input: s}
+	}
+}
+
+// MustParseSwitchWeekday is like ParseSwitchWeekday, but panics if s cannot be parsed.
+func MustParseSwitchWeekday(s string) SwitchWeekday {
+	x, err := ParseSwitchWeekday(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into SwitchWeekday values
+func (s *SwitchWeekday) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseSwitchWeekday(string(token))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// Next returns the next defined SwitchWeekday. If s is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	s := SwitchWeekday(0)
+//	for {
+//		fmt.Println(s)
+//		s = s.Next()
+//		if s == SwitchWeekday(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use SwitchWeekdayValues() to loop through the values in the order they are declared.
+func (s SwitchWeekday) Next() SwitchWeekday {
+	switch s {
+	case SwitchSunday:
+		return SwitchMonday
+	case SwitchMonday:
+		return SwitchTuesday
+	case SwitchTuesday:
+		return SwitchWednesday
+	case SwitchWednesday:
+		return SwitchThursday
+	case SwitchThursday:
+		return SwitchFriday
+	case SwitchFriday:
+		return SwitchSaturday
+	case SwitchSaturday:
+		return SwitchSunday
+	default:
+		return SwitchSunday
+	}
+}
+
+// SwitchWeekdayLen is the number of defined SwitchWeekday values.
+const SwitchWeekdayLen = 7
+
+// SwitchWeekdayValues returns all defined SwitchWeekday values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func SwitchWeekdayValues() []SwitchWeekday {
+	return []SwitchWeekday{SwitchSunday, SwitchMonday, SwitchTuesday, SwitchWednesday, SwitchThursday, SwitchFriday, SwitchSaturday}
+}
+
+// SwitchWeekdayNames returns the names of all defined SwitchWeekday values in the same order as SwitchWeekdayValues.
+// A new slice is returned on every call, so it is safe to modify.
+func SwitchWeekdayNames() []string {
+	return []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[SwitchSunday-0]
+	_ = x[SwitchMonday-1]
+	_ = x[SwitchTuesday-2]
+	_ = x[SwitchWednesday-3]
+	_ = x[SwitchThursday-4]
+	_ = x[SwitchFriday-5]
+	_ = x[SwitchSaturday-6]
+}
+
+// MarshalJSON implements json.Marshaler
+func (s SwitchWeekday) MarshalJSON() ([]byte, error) {
+	x := s.Bytes()
+	y := make([]byte, 0, len(x))
+	return append(append(append(y, '"'), x...), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *SwitchWeekday) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseSwitchWeekday(str)
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s SwitchWeekday) MarshalText() ([]byte, error) {
+	return s.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *SwitchWeekday) UnmarshalText(x []byte) error {
+	y, err := ParseSwitchWeekday(string(x))
+	if err != nil {
+		return err
+	}
+	*s = y
+	return nil
+}
+
+// switchWeekdayClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func switchWeekdayClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
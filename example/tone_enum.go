@@ -0,0 +1,259 @@
+// Code generated by "go-enumerator --tests --transform=title --trim-prefix=Tone" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// toneNames holds the name of every Tone value.
+const toneNames = "BlackLight RedDark Blue"
+
+// toneNameIndex holds the offsets of each Tone value in toneNames.
+var toneNameIndex = [...]uint8{0, 5, 14, 23}
+
+// toneNameIndexOf returns the position of t in toneNameIndex, or -1 if !t.Defined().
+func toneNameIndexOf(t Tone) int {
+	if t < 0 || t > 2 {
+		return -1
+	}
+	return int(t)
+}
+
+// String implements fmt.Stringer. If !t.Defined(), then a generated string is returned based on t's value.
+func (t Tone) String() string {
+	if i := toneNameIndexOf(t); i >= 0 {
+		return toneNames[toneNameIndex[i]:toneNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Tone(%d)", t)
+}
+
+// Bytes returns a byte-level representation of String(). If !t.Defined(), then a generated string is returned based on t's value.
+func (t Tone) Bytes() []byte {
+	if i := toneNameIndexOf(t); i >= 0 {
+		return append([]byte(nil), toneNames[toneNameIndex[i]:toneNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Tone(%d)", t))
+}
+
+// Defined returns true if t holds a defined value.
+func (t Tone) Defined() bool {
+	switch t {
+	case 0, 1, 2:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidTone is matched by the errors returned when a Tone cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidTone = errors.New("invalid Tone")
+
+// InvalidToneError is the error returned when a string is not the name of a Tone.
+// It matches ErrInvalidTone with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidToneError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidToneError) Error() string {
+	msg := fmt.Sprintf("invalid Tone %q: must be one of Black, Light Red or Dark Blue", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidToneError) Suggestions() []string {
+	return toneClosestNames(e.Input, []string{"Black", "Light Red", "Dark Blue"})
+}
+
+// Is returns true if target is ErrInvalidTone.
+func (e *InvalidToneError) Is(target error) bool {
+	return target == ErrInvalidTone
+}
+
+// ParseTone returns the Tone named s. An error is returned if s is not the name of a Tone.
+func ParseTone(s string) (Tone, error) {
+	switch s {
+	case "Black":
+		return ToneBlack, nil
+	case "Light Red":
+		return ToneLightRed, nil
+	case "Dark Blue":
+		return ToneDarkBlue, nil
+	default:
+		return 0, &InvalidToneError{Input: s}
+	}
+}
+
+// MustParseTone is like ParseTone, but panics if s cannot be parsed.
+func MustParseTone(s string) Tone {
+	x, err := ParseTone(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Tone values
+//
+// Some names of Tone contain spaces, so the rest of the line is scanned, rather than a single word.
+func (t *Tone) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, func(r rune) bool {
+		return r != '\n'
+	})
+	if err != nil {
+		return err
+	}
+	token = bytes.TrimSpace(token)
+
+	x, err := ParseTone(string(token))
+	if err != nil {
+		return err
+	}
+	*t = x
+	return nil
+}
+
+// Next returns the next defined Tone. If t is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	t := Tone(0)
+//	for {
+//		fmt.Println(t)
+//		t = t.Next()
+//		if t == Tone(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use ToneValues() to loop through the values in the order they are declared.
+func (t Tone) Next() Tone {
+	switch t {
+	case ToneBlack:
+		return ToneLightRed
+	case ToneLightRed:
+		return ToneDarkBlue
+	case ToneDarkBlue:
+		return ToneBlack
+	default:
+		return ToneBlack
+	}
+}
+
+// ToneLen is the number of defined Tone values.
+const ToneLen = 3
+
+// ToneValues returns all defined Tone values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func ToneValues() []Tone {
+	return []Tone{ToneBlack, ToneLightRed, ToneDarkBlue}
+}
+
+// ToneNames returns the names of all defined Tone values in the same order as ToneValues.
+// A new slice is returned on every call, so it is safe to modify.
+func ToneNames() []string {
+	return []string{"Black", "Light Red", "Dark Blue"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[ToneBlack-0]
+	_ = x[ToneLightRed-1]
+	_ = x[ToneDarkBlue-2]
+}
+
+// MarshalJSON implements json.Marshaler
+func (t Tone) MarshalJSON() ([]byte, error) {
+	if i := toneNameIndexOf(t); i >= 0 {
+		name := toneNames[toneNameIndex[i]:toneNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *Tone) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseTone(str)
+	if err != nil {
+		return err
+	}
+	*t = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t Tone) MarshalText() ([]byte, error) {
+	return t.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *Tone) UnmarshalText(x []byte) error {
+	y, err := ParseTone(string(x))
+	if err != nil {
+		return err
+	}
+	*t = y
+	return nil
+}
+
+// toneClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func toneClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
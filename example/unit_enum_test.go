@@ -0,0 +1,89 @@
+package example
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ajjensen13/go-enumerator/example/units"
+)
+
+func TestUnitString(t *testing.T) {
+	tests := []struct {
+		name string
+		e    units.Unit
+		want string
+	}{
+		{
+			"Meter",
+			units.Meter,
+			"Meter",
+		},
+		{
+			"declared in another file",
+			units.Mile,
+			"Mile",
+		},
+		{
+			"undefined",
+			0,
+			"Unit(0)",
+		},
+		{
+			"unexported",
+			100,
+			"Unit(100)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnitString(tt.e); got != tt.want {
+				t.Errorf("UnitString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitDefined(t *testing.T) {
+	for _, u := range UnitValues() {
+		if !UnitDefined(u) {
+			t.Errorf("UnitDefined(%v) = false, want true", UnitString(u))
+		}
+	}
+	if UnitDefined(4) {
+		t.Error("UnitDefined(4) = true, want false")
+	}
+	if UnitDefined(100) {
+		t.Error("UnitDefined(100) = true, want false for an unexported constant")
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for _, name := range UnitNames() {
+		u, err := ParseUnit(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := UnitString(u); got != name {
+			t.Errorf("UnitString(ParseUnit(%q)) = %q", name, got)
+		}
+	}
+
+	_, err := ParseUnit("Metre")
+	if !errors.Is(err, ErrInvalidUnit) {
+		t.Errorf("errors.Is(%v, ErrInvalidUnit) = false, want true", err)
+	}
+}
+
+func ExampleUnitNext() {
+	u := units.Millimeter
+	for i := 0; i < 3; i++ {
+		fmt.Println(UnitString(u))
+		u = UnitNext(u)
+	}
+
+	// Output:
+	// Millimeter
+	// Meter
+	// Kilometer
+}
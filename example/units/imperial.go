@@ -0,0 +1,7 @@
+package units
+
+const (
+	Inch Unit = iota + 10
+	Foot
+	Mile
+)
@@ -0,0 +1,15 @@
+// Package units declares a type whose constants are spread across several files.
+// The example package generates functions for it, since it cannot declare methods on it.
+package units
+
+// Unit is a unit of length.
+type Unit int
+
+const (
+	Millimeter Unit = iota + 1
+	Meter
+	Kilometer
+)
+
+// micrometer is unexported, so it is left out of the code generated in other packages.
+const micrometer Unit = 100
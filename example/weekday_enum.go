@@ -0,0 +1,273 @@
+// Code generated by "go-enumerator" (devel); DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// weekdayNames holds the name of every Weekday value.
+const weekdayNames = "SundayMondayTuesdayWednesdayThursdayFridaySaturday"
+
+// weekdayNameIndex holds the offsets of each Weekday value in weekdayNames.
+var weekdayNameIndex = [...]uint8{0, 6, 12, 19, 28, 36, 42, 50}
+
+// weekdayNameIndexOf returns the position of w in weekdayNameIndex, or -1 if !w.Defined().
+func weekdayNameIndexOf(w Weekday) int {
+	if w < 0 || w > 6 {
+		return -1
+	}
+	return int(w)
+}
+
+// String implements fmt.Stringer. If !w.Defined(), then a generated string is returned based on w's value.
+func (w Weekday) String() string {
+	if i := weekdayNameIndexOf(w); i >= 0 {
+		return weekdayNames[weekdayNameIndex[i]:weekdayNameIndex[i+1]]
+	}
+	return fmt.Sprintf("Weekday(%d)", w)
+}
+
+// Bytes returns a byte-level representation of String(). If !w.Defined(), then a generated string is returned based on w's value.
+func (w Weekday) Bytes() []byte {
+	if i := weekdayNameIndexOf(w); i >= 0 {
+		return append([]byte(nil), weekdayNames[weekdayNameIndex[i]:weekdayNameIndex[i+1]]...)
+	}
+	return []byte(fmt.Sprintf("Weekday(%d)", w))
+}
+
+// Defined returns true if w holds a defined value.
+func (w Weekday) Defined() bool {
+	switch w {
+	case 0, 1, 2, 3, 4, 5, 6:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidWeekday is matched by the errors returned when a Weekday cannot be parsed. Use errors.Is to check for it.
+var ErrInvalidWeekday = errors.New("invalid Weekday")
+
+// InvalidWeekdayError is the error returned when a string is not the name of a Weekday.
+// It matches ErrInvalidWeekday with errors.Is. Use errors.As to get the input that was rejected.
+type InvalidWeekdayError struct {
+	// Input is the string that could not be parsed.
+	Input string
+}
+
+// Error implements error. The message lists the names of the defined values,
+// followed by the names returned by Suggestions(), if there are any.
+func (e *InvalidWeekdayError) Error() string {
+	msg := fmt.Sprintf("invalid Weekday %q: must be one of Sunday, Monday, Tuesday, Wednesday, Thursday, Friday or Saturday", e.Input)
+	if s := e.Suggestions(); len(s) > 0 {
+		msg += " (did you mean " + strings.Join(s, " or ") + "?)"
+	}
+	return msg
+}
+
+// Suggestions returns the names that e.Input is closest to, in the order they are declared. Names are
+// compared case-insensitively, and only names that differ from e.Input by a few characters are returned.
+func (e *InvalidWeekdayError) Suggestions() []string {
+	return weekdayClosestNames(e.Input, []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"})
+}
+
+// Is returns true if target is ErrInvalidWeekday.
+func (e *InvalidWeekdayError) Is(target error) bool {
+	return target == ErrInvalidWeekday
+}
+
+// ParseWeekday returns the Weekday named s. An error is returned if s is not the name of a Weekday.
+func ParseWeekday(s string) (Weekday, error) {
+	switch s {
+	case "Sunday":
+		return Sunday, nil
+	case "Monday":
+		return Monday, nil
+	case "Tuesday":
+		return Tuesday, nil
+	case "Wednesday":
+		return Wednesday, nil
+	case "Thursday":
+		return Thursday, nil
+	case "Friday":
+		return Friday, nil
+	case "Saturday":
+		return Saturday, nil
+	default:
+		return 0, &InvalidWeekdayError{Input: s}
+	}
+}
+
+// MustParseWeekday is like ParseWeekday, but panics if s cannot be parsed.
+func MustParseWeekday(s string) Weekday {
+	x, err := ParseWeekday(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Scan implements fmt.Scanner. Use fmt.Scan() to parse strings into Weekday values
+func (w *Weekday) Scan(scanState fmt.ScanState, verb rune) error {
+	token, err := scanState.Token(true, nil)
+	if err != nil {
+		return err
+	}
+
+	x, err := ParseWeekday(string(token))
+	if err != nil {
+		return err
+	}
+	*w = x
+	return nil
+}
+
+// Next returns the next defined Weekday. If w is not defined, then Next returns the first defined value.
+// Next() can be used to loop through all values of an enum.
+//
+//	w := Weekday(0)
+//	for {
+//		fmt.Println(w)
+//		w = w.Next()
+//		if w == Weekday(0) {
+//			break
+//		}
+//	}
+//
+// The exact order that values are returned when looping should not be relied upon.
+// Use WeekdayValues() to loop through the values in the order they are declared.
+func (w Weekday) Next() Weekday {
+	switch w {
+	case Sunday:
+		return Monday
+	case Monday:
+		return Tuesday
+	case Tuesday:
+		return Wednesday
+	case Wednesday:
+		return Thursday
+	case Thursday:
+		return Friday
+	case Friday:
+		return Saturday
+	case Saturday:
+		return Sunday
+	default:
+		return Sunday
+	}
+}
+
+// WeekdayLen is the number of defined Weekday values.
+const WeekdayLen = 7
+
+// WeekdayValues returns all defined Weekday values in the order they are declared.
+// A new slice is returned on every call, so it is safe to modify.
+func WeekdayValues() []Weekday {
+	return []Weekday{Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday}
+}
+
+// WeekdayNames returns the names of all defined Weekday values in the same order as WeekdayValues.
+// A new slice is returned on every call, so it is safe to modify.
+func WeekdayNames() []string {
+	return []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+}
+
+func _() {
+	var x [1]struct{}
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the go-enumerator command to generate them again.
+	_ = x[Sunday-0]
+	_ = x[Monday-1]
+	_ = x[Tuesday-2]
+	_ = x[Wednesday-3]
+	_ = x[Thursday-4]
+	_ = x[Friday-5]
+	_ = x[Saturday-6]
+}
+
+// MarshalJSON implements json.Marshaler
+func (w Weekday) MarshalJSON() ([]byte, error) {
+	if i := weekdayNameIndexOf(w); i >= 0 {
+		name := weekdayNames[weekdayNameIndex[i]:weekdayNameIndex[i+1]]
+		return append(append(append(make([]byte, 0, len(name)+2), '"'), name...), '"'), nil
+	}
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (w *Weekday) UnmarshalJSON(x []byte) error {
+	var str string
+	if err := json.Unmarshal(x, &str); err != nil {
+		return err
+	}
+
+	y, err := ParseWeekday(str)
+	if err != nil {
+		return err
+	}
+	*w = y
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (w Weekday) MarshalText() ([]byte, error) {
+	return w.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (w *Weekday) UnmarshalText(x []byte) error {
+	y, err := ParseWeekday(string(x))
+	if err != nil {
+		return err
+	}
+	*w = y
+	return nil
+}
+
+// weekdayClosestNames returns the names that input is closest to, in the order they are given. Names are compared
+// case-insensitively by their edit distance from input, and names more than a third of their length away are left out.
+func weekdayClosestNames(input string, names []string) []string {
+	in := []rune(strings.ToLower(input))
+	var ret []string
+	best := -1
+	for _, name := range names {
+		target := []rune(strings.ToLower(name))
+		// prev and cur are consecutive rows of the edit distance matrix.
+		prev := make([]int, len(target)+1)
+		cur := make([]int, len(target)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+		for i := range in {
+			cur[0] = i + 1
+			for j := range target {
+				d := prev[j]
+				if in[i] != target[j] {
+					d++
+				}
+				if prev[j+1]+1 < d {
+					d = prev[j+1] + 1
+				}
+				if cur[j]+1 < d {
+					d = cur[j] + 1
+				}
+				cur[j+1] = d
+			}
+			prev, cur = cur, prev
+		}
+
+		d := prev[len(target)]
+		switch {
+		case d > len(target)/3:
+		case best < 0 || d < best:
+			best, ret = d, []string{name}
+		case d == best:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
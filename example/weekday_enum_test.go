@@ -0,0 +1,195 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestWeekday_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Weekday
+		want string
+	}{
+		{
+			"Sunday",
+			Sunday,
+			"Sunday",
+		},
+		{
+			"Saturday",
+			Saturday,
+			"Saturday",
+		},
+		{
+			"undefined",
+			7,
+			"Weekday(7)",
+		},
+		{
+			"negative",
+			-1,
+			"Weekday(-1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := string(tt.e.Bytes()); got != tt.want {
+				t.Errorf("Bytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekday_Layouts(t *testing.T) {
+	for i := 0; i < WeekdayLen; i++ {
+		table, swtch := Weekday(i), SwitchWeekday(i)
+		if table.String() != swtch.String() {
+			t.Errorf("Weekday(%d).String() = %v, SwitchWeekday(%d).String() = %v", i, table, i, swtch)
+		}
+
+		tableJson, err := json.Marshal(table)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		swtchJson, err := json.Marshal(swtch)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(tableJson) != string(swtchJson) {
+			t.Errorf("json.Marshal(Weekday(%d)) = %s, json.Marshal(SwitchWeekday(%d)) = %s", i, tableJson, i, swtchJson)
+		}
+	}
+}
+
+func TestWeekday_Allocs(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+		want float64
+	}{
+		{
+			"String",
+			func() { _ = Wednesday.String() },
+			0,
+		},
+		{
+			// Bytes returns a copy of the name, so that the table cannot be modified.
+			"Bytes",
+			func() { _ = Wednesday.Bytes() },
+			1,
+		},
+		{
+			"MarshalJSON",
+			func() { _, _ = Wednesday.MarshalJSON() },
+			1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.f); allocs != tt.want {
+				t.Errorf("AllocsPerRun() = %v, want %v", allocs, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignal_String(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Signal
+		want string
+	}{
+		{
+			"SignalHangup",
+			SignalHangup,
+			"SignalHangup",
+		},
+		{
+			"SignalTerminate",
+			SignalTerminate,
+			"SignalTerminate",
+		},
+		{
+			"undefined",
+			3,
+			"Signal(3)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := string(tt.e.Bytes()); got != tt.want {
+				t.Errorf("Bytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignal_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal([]Signal{SignalKill, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `["SignalKill","Signal(3)"]`; string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+var (
+	benchmarkString string
+	benchmarkBytes  []byte
+)
+
+func BenchmarkWeekday_String(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkString = Weekday(i % 7).String()
+	}
+}
+
+func BenchmarkSwitchWeekday_String(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkString = SwitchWeekday(i % 7).String()
+	}
+}
+
+func BenchmarkWeekday_Bytes(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkBytes = Weekday(i % 7).Bytes()
+	}
+}
+
+func BenchmarkSwitchWeekday_Bytes(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkBytes = SwitchWeekday(i % 7).Bytes()
+	}
+}
+
+func BenchmarkWeekday_MarshalJSON(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkBytes, _ = Weekday(i % 7).MarshalJSON()
+	}
+}
+
+func BenchmarkSwitchWeekday_MarshalJSON(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkBytes, _ = SwitchWeekday(i % 7).MarshalJSON()
+	}
+}
+
+func ExampleInvalidWeekdayError_Suggestions() {
+	_, err := ParseWeekday("sunday")
+	fmt.Println(err)
+
+	// Output:
+	// invalid Weekday "sunday": must be one of Sunday, Monday, Tuesday, Wednesday, Thursday, Friday or Saturday (did you mean Sunday?)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// checkEnvVar is the environment variable that enables --check when it is not specified.
+// It allows CI to check every file with a single "go generate" run.
+const checkEnvVar = "GO_ENUMERATOR_CHECK"
+
+// fileChecker compares generated files with the files on disk, instead of writing them.
+type fileChecker struct {
+	// w receives a unified diff for each file that is out of date.
+	w io.Writer
+	// stale holds the names of the files that are out of date.
+	stale []string
+}
+
+// check compares b with the file named name. If they differ, a diff
+// is written to c.w, and name is recorded as out of date.
+// A missing file is treated as an empty one.
+func (c *fileChecker) check(b []byte, name string) error {
+	switch name {
+	case "<STDOUT>", "<STDERR>":
+		return fmt.Errorf("cannot check output written to %s", name)
+	}
+
+	existing, err := os.ReadFile(name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if bytes.Equal(existing, b) {
+		return nil
+	}
+
+	c.stale = append(c.stale, name)
+	return writeUnifiedDiff(c.w, name, name+" (generated)", string(existing), string(b))
+}
+
+// err returns an error listing the files that are out of date, if there are any.
+// It is safe to call on a nil *fileChecker.
+func (c *fileChecker) err() error {
+	if c == nil || len(c.stale) == 0 {
+		return nil
+	}
+	return fmt.Errorf("generated files are out of date, re-run go generate: %s", strings.Join(c.stale, ", "))
+}
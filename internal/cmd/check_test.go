@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRootCmd_Check(t *testing.T) {
+	tests := []struct {
+		name string
+		// stale is appended to the generated file before checking it. If it is "missing", the file is removed.
+		stale   string
+		env     string
+		args    []string
+		want    []string
+		wantErr string
+		// written is true if the file is expected to be regenerated instead of checked.
+		written bool
+	}{
+		{"flag, up to date", "", "", []string{"--check"}, nil, "", false},
+		{
+			"flag, out of date",
+			"// edited\n",
+			"",
+			[]string{"--check"},
+			[]string{"--- ", "+++ ", "-// edited"},
+			"generated files are out of date",
+			false,
+		},
+		{
+			"flag, missing",
+			"missing",
+			"",
+			[]string{"--check"},
+			[]string{"+package multi"},
+			"generated files are out of date",
+			false,
+		},
+		{"env, up to date", "", "1", nil, nil, "", false},
+		{
+			"env, out of date",
+			"// edited\n",
+			"true",
+			nil,
+			[]string{"-// edited"},
+			"generated files are out of date",
+			false,
+		},
+		{"flag takes precedence over env", "// edited\n", "1", []string{"--check=false"}, nil, "", true},
+		{"env disabled", "// edited\n", "0", nil, nil, "", true},
+		{"env invalid", "", "maybe", nil, nil, `invalid value "maybe" for check`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			output := filepath.Join(dir, "color_enum.go")
+			args := []string{
+				"--input", filepath.Join("testdata", "multi", "multi.go"),
+				"--pkg", "multi",
+				"--type", "Color",
+				"--output", output,
+			}
+
+			t.Setenv(checkEnvVar, "")
+			if err := execute(args...); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			generated, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			before := string(generated) + tt.stale
+			if tt.stale == "missing" {
+				if err := os.Remove(output); err != nil {
+					t.Fatal(err)
+				}
+			} else if err := os.WriteFile(output, []byte(before), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			t.Setenv(checkEnvVar, tt.env)
+			out, err := executeOutput(append(args, tt.args...)...)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Execute() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("Execute() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("Execute() error = %v, want an error containing %q", err, tt.wantErr)
+			}
+
+			if len(tt.want) == 0 && out != "" {
+				t.Errorf("output = %q, want no output", out)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+
+			after, err := os.ReadFile(output)
+			switch {
+			case tt.stale == "missing":
+				if err == nil {
+					t.Errorf("%s was written", output)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case tt.written && string(after) != string(generated):
+				t.Errorf("%s was not regenerated", output)
+			case !tt.written && string(after) != before:
+				t.Errorf("%s was modified", output)
+			}
+		})
+	}
+}
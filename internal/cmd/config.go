@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ajjensen13/go-enumerator/internal/ident"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// configFileNames are the names of the configuration files that are searched for,
+// in every directory from the directory of the input file up to the root.
+var configFileNames = []string{".go-enumerator.yaml", ".go-enumerator.yml", ".go-enumerator.json"}
+
+// configKeys are the options that can be set in a configuration file. Each one is named like the flag it
+// provides a default for. The value is true if the option can also be overridden for a single type.
+var configKeys = map[string]bool{
+	"receiver":    true,
+	"flags":       true,
+	"trim-prefix": true,
+	"transform":   true,
+	"ignore-case": true,
+	"layout":      true,
+	"methods":     true,
+	"skip":        true,
+	"sql":         true,
+	"tests":       true,
+	"fuzz":        true,
+	"output":      true,
+	"header":      false,
+}
+
+// configTypesKey is the key of the per-type overrides in a configuration file.
+const configTypesKey = "types"
+
+// defaultOutput is the template for the name of the output file of a type when --output is not specified.
+const defaultOutput = "{{.Unexported}}_enum.go"
+
+// config holds the options read from a configuration file.
+// A nil *config holds no options.
+type config struct {
+	// path is the path of the configuration file.
+	path string
+	// options holds the options that apply to every type, as they would be passed to the flag of the same name.
+	options map[string]string
+	// types holds the options that only apply to the type with the given name.
+	types map[string]map[string]string
+}
+
+// findConfig returns the path of the configuration file that applies to the file named inputFileName.
+// If there is no configuration file, an empty string is returned.
+func findConfig(inputFileName string) (string, error) {
+	dir, err := filepath.Abs(filepath.Dir(inputFileName))
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		var found []string
+		for _, name := range configFileNames {
+			path := filepath.Join(dir, name)
+			_, err := os.Stat(path)
+			switch {
+			case err == nil:
+				found = append(found, path)
+			case !errors.Is(err, os.ErrNotExist):
+				return "", err
+			}
+		}
+
+		switch len(found) {
+		case 0:
+		case 1:
+			return found[0], nil
+		default:
+			return "", fmt.Errorf("multiple configuration files found: %s", strings.Join(found, ", "))
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// loadConfig reads the configuration file at path. If path is empty, nil is returned.
+// fs holds the flags that the options in the file provide defaults for.
+func loadConfig(path string, fs *pflag.FlagSet) (*config, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if filepath.Ext(path) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		err = dec.Decode(&raw)
+	} else {
+		err = yaml.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	ret := &config{path: path, types: make(map[string]map[string]string)}
+	types := raw[configTypesKey]
+	delete(raw, configTypesKey)
+
+	ret.options, err = configOptions(raw, fs, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if types == nil {
+		return ret, nil
+	}
+
+	m, ok := types.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must map type names to options", path, configTypesKey)
+	}
+
+	for name, v := range m {
+		opts, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: options of type %s must be a map", path, name)
+		}
+
+		ret.types[name], err = configOptions(opts, fs, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: type %s: %w", path, name, err)
+		}
+	}
+
+	return ret, nil
+}
+
+// configOptions converts the options in raw into the values that would be passed to the flags of the same name.
+// Lists are joined with commas. If perType is true, only options that can be overridden for a single type are allowed.
+func configOptions(raw map[string]interface{}, fs *pflag.FlagSet, perType bool) (map[string]string, error) {
+	ret := make(map[string]string, len(raw))
+	for key, v := range raw {
+		allowed, ok := configKeys[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown option %q", key)
+		}
+
+		if perType && !allowed {
+			return nil, fmt.Errorf("option %q cannot be set for a single type", key)
+		}
+
+		s, err := configValue(v, fs.Lookup(key).Value.Type() == "bool")
+		if err != nil {
+			return nil, fmt.Errorf("option %q: %w", key, err)
+		}
+		ret[key] = s
+	}
+	return ret, nil
+}
+
+// configValue converts the value v of an option to a string. If isBool is true, v must be a boolean.
+// Otherwise, v must be a string, or a list of strings.
+func configValue(v interface{}, isBool bool) (string, error) {
+	if isBool {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("must be true or false, not %v", v)
+		}
+		return strconv.FormatBool(b), nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []interface{}:
+		ss := make([]string, len(v))
+		for i, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return "", fmt.Errorf("must be a list of strings, not %v", v)
+			}
+			ss[i] = s
+		}
+		return strings.Join(ss, ","), nil
+	default:
+		return "", fmt.Errorf("must be a string, not %v", v)
+	}
+}
+
+// lookup returns the value of the option named name for the type named typeName.
+// Options set for the type take precedence over options set for every type.
+// If typeName is empty, only options set for every type are considered.
+func (c *config) lookup(name, typeName string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	if v, ok := c.types[typeName][name]; ok {
+		return v, true
+	}
+
+	v, ok := c.options[name]
+	return v, ok
+}
+
+// resolveOptionValue returns the value of the option named by f for the type named typeName.
+// The value from f is used if it was specified by the user. Otherwise, the value is looked up in c.
+// If it is not found there either, the default value of f is returned.
+func resolveOptionValue(f *pflag.Flag, c *config, typeName string) (string, bool) {
+	if f.Changed {
+		return f.Value.String(), true
+	}
+
+	if v, ok := c.lookup(f.Name, typeName); ok {
+		return v, true
+	}
+
+	return f.DefValue, false
+}
+
+// resolveBoolOptionValue is like resolveOptionValue, but parses the value as a bool.
+func resolveBoolOptionValue(f *pflag.Flag, c *config, typeName string) (bool, bool, error) {
+	s, ok := resolveOptionValue(f, c, typeName)
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid value %q for %s: %w", s, f.Name, err)
+	}
+	return v, ok, nil
+}
+
+// outputFileData is the data that the output file name template is executed with.
+type outputFileData struct {
+	// Type is the name of the type.
+	Type string
+	// Unexported is the name of the type, with its first letter in lower case.
+	Unexported string
+}
+
+// outputFileName executes the output file name template text for the type named typeName.
+func outputFileName(text, typeName string) (string, error) {
+	t, err := template.New("output").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid output template: %w", err)
+	}
+
+	var sb strings.Builder
+	err = t.Execute(&sb, outputFileData{Type: typeName, Unexported: ident.Unexported(typeName)})
+	if err != nil {
+		return "", fmt.Errorf("invalid output template: %w", err)
+	}
+
+	if sb.Len() == 0 {
+		return "", fmt.Errorf("output template %q produced an empty file name for type %s", text, typeName)
+	}
+
+	return sb.String(), nil
+}
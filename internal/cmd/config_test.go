@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ajjensen13/go-enumerator/enumerator"
+)
+
+// writeFiles writes files, which maps paths relative to dir to their contents, into dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFindConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{"yaml", map[string]string{"a/.go-enumerator.yaml": ""}, "a/x.go", "a/.go-enumerator.yaml", ""},
+		{"yml", map[string]string{"a/.go-enumerator.yml": ""}, "a/x.go", "a/.go-enumerator.yml", ""},
+		{"json", map[string]string{"a/.go-enumerator.json": "{}"}, "a/x.go", "a/.go-enumerator.json", ""},
+		{"parent directory", map[string]string{".go-enumerator.yaml": ""}, "a/b/x.go", ".go-enumerator.yaml", ""},
+		{
+			"nearest directory",
+			map[string]string{".go-enumerator.yaml": "", "a/.go-enumerator.json": "{}"},
+			"a/b/x.go",
+			"a/.go-enumerator.json",
+			"",
+		},
+		{"none", nil, "a/x.go", "", ""},
+		{
+			"multiple",
+			map[string]string{"a/.go-enumerator.yaml": "", "a/.go-enumerator.json": "{}"},
+			"a/x.go",
+			"",
+			"multiple configuration files found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			got, err := findConfig(filepath.Join(dir, tt.input))
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("findConfig() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("findConfig() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("findConfig() error = %v, want an error containing %q", err, tt.wantErr)
+			case tt.wantErr != "":
+				return
+			}
+
+			want := tt.want
+			if want != "" {
+				want = filepath.Join(dir, want)
+			}
+			if got != want {
+				t.Errorf("findConfig() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	want := &config{
+		options: map[string]string{"receiver": "x", "skip": "Text,Sql", "tests": "true"},
+		types:   map[string]map[string]string{"Color": {"sql": "name"}},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			".go-enumerator.yaml",
+			"receiver: x\nskip: [Text, Sql]\ntests: true\ntypes:\n  Color:\n    sql: name\n",
+			"",
+		},
+		{
+			".go-enumerator.yml",
+			"receiver: x\nskip:\n  - Text\n  - Sql\ntests: true\ntypes:\n  Color: {sql: name}\n",
+			"",
+		},
+		{
+			".go-enumerator.json",
+			`{"receiver": "x", "skip": ["Text", "Sql"], "tests": true, "types": {"Color": {"sql": "name"}}}`,
+			"",
+		},
+		{".go-enumerator.yaml", "color: red\n", `unknown option "color"`},
+		{".go-enumerator.yaml", "types:\n  Color:\n    header: x\n", `type Color: option "header" cannot be set for a single type`},
+		{".go-enumerator.yaml", "tests: yes please\n", `option "tests": must be true or false`},
+		{".go-enumerator.yaml", "receiver: [1]\n", `option "receiver": must be a list of strings`},
+		{".go-enumerator.json", `{"types": ["Color"]}`, "types must map type names to options"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.name)
+			writeFiles(t, filepath.Dir(path), map[string]string{tt.name: tt.content})
+
+			got, err := loadConfig(path, rootCmd.Flags())
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("loadConfig() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("loadConfig() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("loadConfig() error = %v, want an error containing %q", err, tt.wantErr)
+			case tt.wantErr != "":
+				return
+			}
+
+			if !reflect.DeepEqual(got.options, want.options) || !reflect.DeepEqual(got.types, want.types) {
+				t.Errorf("loadConfig() = %v %v, want %v %v", got.options, got.types, want.options, want.types)
+			}
+		})
+	}
+}
+
+func TestResolveEnumOptions(t *testing.T) {
+	cfg := &config{
+		options: map[string]string{"receiver": "c", "layout": "switch", "flags": "false", "output": "{{.Type}}.go"},
+		types:   map[string]map[string]string{"Color": {"receiver": "t", "sql": "name"}},
+	}
+	no := false
+
+	tests := []struct {
+		name     string
+		args     []string
+		cfg      *config
+		typeName string
+		want     enumerator.Options
+	}{
+		{
+			"default",
+			nil,
+			nil,
+			"Color",
+			enumerator.Options{Layout: "table", Skip: []string{""}, Output: "color_enum.go"},
+		},
+		{
+			"top-level option",
+			nil,
+			cfg,
+			"Shape",
+			enumerator.Options{Receiver: "c", Flags: &no, Layout: "switch", Skip: []string{""}, Output: "Shape.go"},
+		},
+		{
+			"option for the type",
+			nil,
+			cfg,
+			"Color",
+			enumerator.Options{Receiver: "t", Flags: &no, Layout: "switch", SQL: "name", Skip: []string{""}, Output: "Color.go"},
+		},
+		{
+			"flag",
+			[]string{"--receiver", "f", "--layout", "table", "--output", "out.go"},
+			cfg,
+			"Color",
+			enumerator.Options{Receiver: "f", Flags: &no, Layout: "table", SQL: "name", Skip: []string{""}, Output: "out.go"},
+		},
+		{
+			"flag set to its default value",
+			[]string{"--receiver", ""},
+			cfg,
+			"Color",
+			enumerator.Options{Flags: &no, Layout: "switch", SQL: "name", Skip: []string{""}, Output: "Color.go"},
+		},
+		{
+			"imported type",
+			nil,
+			nil,
+			"example.com/pkg.Kind",
+			enumerator.Options{Layout: "table", Skip: []string{""}, Output: "kind_enum.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags()
+			if err := rootCmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := resolveEnumOptions(rootCmd.Flags(), tt.cfg, tt.typeName)
+			if err != nil {
+				t.Fatalf("resolveEnumOptions() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveEnumOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRootCmd_Config(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"options from the file", nil, "func (x Color) String() string"},
+		{"flag takes precedence", []string{"--receiver", "z"}, "func (z Color) String() string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "config.yaml")
+			writeFiles(t, dir, map[string]string{
+				"config.yaml": "receiver: r\noutput: " + filepath.Join(dir, "{{.Type}}.go") + "\ntypes:\n  Color:\n    receiver: x\n",
+			})
+
+			args := append([]string{
+				"--input", filepath.Join("testdata", "multi", "multi.go"),
+				"--pkg", "multi",
+				"--type", "Color",
+				"--config", path,
+			}, tt.args...)
+			if err := execute(args...); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			b, err := os.ReadFile(filepath.Join(dir, "Color.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(b), tt.want) {
+				t.Errorf("generated code does not contain %q", tt.want)
+			}
+		})
+	}
+}
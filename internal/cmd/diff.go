@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+// diffOp is the kind of an edit in an edit script.
+type diffOp byte
+
+const (
+	diffEqual  diffOp = ' '
+	diffDelete diffOp = '-'
+	diffInsert diffOp = '+'
+)
+
+// diffEdit is a single line of an edit script.
+type diffEdit struct {
+	op   diffOp
+	line string
+}
+
+// splitLines splits s into lines, keeping the line endings.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script that turns a into b, computed with the
+// linear space variant of the Myers O(ND) difference algorithm. Instead of keeping every
+// step of the search, it finds the middle snake of the shortest path and recurses on the
+// parts before and after it, so memory use is proportional to len(a)+len(b).
+func diffLines(a, b []string) []diffEdit {
+	var ret []diffEdit
+	diffRange(a, b, &ret)
+	return ret
+}
+
+// diffRange appends the shortest edit script that turns a into b to ret.
+func diffRange(a, b []string, ret *[]diffEdit) {
+	// Common prefixes and suffixes are unchanged, and cannot be part of the middle snake.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		*ret = append(*ret, diffEdit{diffEqual, a[prefix]})
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for _, line := range b {
+			*ret = append(*ret, diffEdit{diffInsert, line})
+		}
+	case len(b) == 0:
+		for _, line := range a {
+			*ret = append(*ret, diffEdit{diffDelete, line})
+		}
+	default:
+		x, y, u, v := middleSnake(a, b)
+		diffRange(a[:x], b[:y], ret)
+		for _, line := range a[x:u] {
+			*ret = append(*ret, diffEdit{diffEqual, line})
+		}
+		diffRange(a[u:], b[v:], ret)
+	}
+
+	for _, line := range common {
+		*ret = append(*ret, diffEdit{diffEqual, line})
+	}
+}
+
+// middleSnake returns the snake from (x, y) to (u, v) that is in the middle of a shortest
+// edit script turning a into b. The search runs forwards from the start and backwards from
+// the end at the same time, until the paths overlap. a and b must not be empty, and must not
+// start or end with the same line, so that the snake never covers the whole of a and b.
+func middleSnake(a, b []string) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+	max := (n + m + 1) / 2
+	offset := max + 1
+
+	// fwd[k+offset] is the furthest x reached on diagonal k = x-y by the forward search.
+	// bwd[k+offset] is the same for the backward search, which runs on the reversed inputs.
+	// Diagonal k of the backward search is diagonal delta-k of the forward search.
+	fwd := make([]int, 2*max+3)
+	bwd := make([]int, 2*max+3)
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && fwd[k-1+offset] < fwd[k+1+offset]) {
+				x = fwd[k+1+offset]
+			} else {
+				x = fwd[k-1+offset] + 1
+			}
+
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			fwd[k+offset] = x
+
+			if c := delta - k; odd && c >= -(d-1) && c <= d-1 && x+bwd[c+offset] >= n {
+				return x0, y0, x, y
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && bwd[k-1+offset] < bwd[k+1+offset]) {
+				x = bwd[k+1+offset]
+			} else {
+				x = bwd[k-1+offset] + 1
+			}
+
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x++
+				y++
+			}
+			bwd[k+offset] = x
+
+			if c := delta - k; !odd && c >= -d && c <= d && x+fwd[c+offset] >= n {
+				return n - x, m - y, n - x0, m - y0
+			}
+		}
+	}
+
+	panic("no middle snake found")
+}
+
+// writeUnifiedDiff writes the differences between a and b to w in the unified diff format.
+// aName and bName are the names used in the header. Nothing is written if a and b are equal.
+func writeUnifiedDiff(w io.Writer, aName, bName, a, b string) error {
+	edits := diffLines(splitLines(a), splitLines(b))
+
+	// Group changes into hunks. Changes that are separated by at most
+	// 2*diffContext unchanged lines share a hunk.
+	var hunks [][2]int
+	for i := 0; i < len(edits); i++ {
+		if edits[i].op == diffEqual {
+			continue
+		}
+
+		if n := len(hunks); n > 0 && i-hunks[n-1][1] <= 2*diffContext {
+			hunks[n-1][1] = i + 1
+			continue
+		}
+
+		hunks = append(hunks, [2]int{i, i + 1})
+	}
+
+	for i := range hunks {
+		hunks[i][0] -= diffContext
+		if hunks[i][0] < 0 {
+			hunks[i][0] = 0
+		}
+
+		hunks[i][1] += diffContext
+		if hunks[i][1] > len(edits) {
+			hunks[i][1] = len(edits)
+		}
+	}
+
+	if len(hunks) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, "--- %s\n+++ %s\n", aName, bName); err != nil {
+		return err
+	}
+
+	aLine, bLine, pos := 0, 0, 0
+	for _, h := range hunks {
+		for ; pos < h[0]; pos++ {
+			aLine, bLine = advanceLines(edits[pos].op, aLine, bLine)
+		}
+
+		var aLen, bLen int
+		for _, e := range edits[h[0]:h[1]] {
+			aLen, bLen = advanceLines(e.op, aLen, bLen)
+		}
+
+		if _, err := fmt.Fprintf(w, "@@ -%s +%s @@\n", hunkRange(aLine, aLen), hunkRange(bLine, bLen)); err != nil {
+			return err
+		}
+
+		for ; pos < h[1]; pos++ {
+			e := edits[pos]
+			aLine, bLine = advanceLines(e.op, aLine, bLine)
+
+			line := e.line
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n\\ No newline at end of file\n"
+			}
+
+			if _, err := fmt.Fprintf(w, "%c%s", e.op, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// advanceLines returns the line counts of both sides of a diff after an edit of op.
+func advanceLines(op diffOp, a, b int) (int, int) {
+	switch op {
+	case diffDelete:
+		return a + 1, b
+	case diffInsert:
+		return a, b + 1
+	default:
+		return a + 1, b + 1
+	}
+}
+
+// hunkRange formats the range of a hunk that starts after line start and contains n lines.
+func hunkRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if n == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
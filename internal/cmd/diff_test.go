@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// applyEdits returns the two sides of the edit script edits.
+func applyEdits(edits []diffEdit) (a, b []string) {
+	for _, e := range edits {
+		if e.op != diffInsert {
+			a = append(a, e.line)
+		}
+		if e.op != diffDelete {
+			b = append(b, e.line)
+		}
+	}
+	return a, b
+}
+
+// countEdits returns the number of inserted and deleted lines in edits.
+func countEdits(edits []diffEdit) int {
+	n := 0
+	for _, e := range edits {
+		if e.op != diffEqual {
+			n++
+		}
+	}
+	return n
+}
+
+// lcsEdits returns the length of the shortest edit script that turns a into b,
+// computed from the longest common subsequence.
+func lcsEdits(a, b []string) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] > lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	return len(a) + len(b) - 2*lcs[0][0]
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			"empty",
+			"",
+			"",
+			"",
+		},
+		{
+			"identical",
+			"a b c",
+			"a b c",
+			" a b c",
+		},
+		{
+			"only insertions",
+			"b d",
+			"a b c d e",
+			"+a b+c d+e",
+		},
+		{
+			"only deletions",
+			"a b c d e",
+			"b d",
+			"-a b-c d-e",
+		},
+		{
+			"insert into empty",
+			"",
+			"a b",
+			"+a+b",
+		},
+		{
+			"delete everything",
+			"a b",
+			"",
+			"-a-b",
+		},
+		{
+			"replace",
+			"a x c",
+			"a y c",
+			" a-x+y c",
+		},
+		{
+			"mixed",
+			"a b c a b b a",
+			"c b a b a c",
+			"-a+c b-c a b-b a+c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := strings.Fields(tt.a), strings.Fields(tt.b)
+
+			var sb strings.Builder
+			for _, e := range diffLines(a, b) {
+				sb.WriteByte(byte(e.op))
+				sb.WriteString(e.line)
+			}
+
+			if got := sb.String(); got != tt.want {
+				t.Errorf("diffLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLines_Shortest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	lines := func() []string {
+		ret := make([]string, r.Intn(20))
+		for i := range ret {
+			ret[i] = string(rune('a' + r.Intn(4)))
+		}
+		return ret
+	}
+
+	for i := 0; i < 1000; i++ {
+		a, b := lines(), lines()
+		edits := diffLines(a, b)
+
+		gotA, gotB := applyEdits(edits)
+		if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+			t.Fatalf("diffLines(%q, %q) = %v, which turns %q into %q", a, b, edits, gotA, gotB)
+		}
+
+		if got, want := countEdits(edits), lcsEdits(a, b); got != want {
+			t.Fatalf("diffLines(%q, %q) has %d edits, want %d", a, b, got, want)
+		}
+	}
+}
+
+func TestWriteUnifiedDiff(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeUnifiedDiff(&buf, "old", "new", "a\nb\nc\n", "a\nc\nd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n\\ No newline at end of file\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeUnifiedDiff() = %q, want %q", got, want)
+	}
+}
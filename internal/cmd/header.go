@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/spf13/pflag"
+)
+
+// defaultHeader is the template used for the header of generated files when --header is not specified.
+// It matches the convention for generated files described in https://go.dev/s/generatedcode.
+const defaultHeader = `Code generated by "{{.Command}}" {{.Version}}; DO NOT EDIT.`
+
+// headerFlags are the flags that affect the generated code, sorted by name. Only
+// these flags are recorded in the header, so that the header does not depend on
+// file paths, or on how the tool was invoked.
+var headerFlags = []string{"flags", "fuzz", "ignore-case", "layout", "methods", "receiver", "skip", "sql", "tests", "transform", "trim-prefix"}
+
+// headerData is the data that the header template is executed with.
+type headerData struct {
+	// Tool is the name of the tool.
+	Tool string
+	// Version is the version of the tool, or "(devel)".
+	Version string
+	// Flags are the flags that affect the generated code, sorted by name.
+	// Options from a configuration file are included as flags.
+	Flags string
+	// Command is Tool followed by Flags.
+	Command string
+}
+
+// newHeaderData returns the header data for the flags of fs that were specified by the user,
+// and the options in c that apply to the type named typeName. If typeName is empty, only
+// the options in c that apply to every type are included.
+func newHeaderData(fs *pflag.FlagSet, c *config, typeName string) headerData {
+	var flags []string
+	for _, name := range headerFlags {
+		f := fs.Lookup(name)
+		v, ok := resolveOptionValue(f, c, typeName)
+		switch {
+		case !ok:
+		case f.Value.Type() == "bool" && v == "true":
+			flags = append(flags, "--"+name)
+		case v == "" || strings.ContainsAny(v, `"'\`) || strings.IndexFunc(v, unicode.IsSpace) >= 0:
+			flags = append(flags, "--"+name+"="+strconv.Quote(v))
+		default:
+			flags = append(flags, "--"+name+"="+v)
+		}
+	}
+
+	ret := headerData{
+		Tool:    toolName,
+		Version: toolVersion(),
+		Flags:   strings.Join(flags, " "),
+	}
+
+	ret.Command = strings.TrimSpace(ret.Tool + " " + ret.Flags)
+	return ret
+}
+
+// renderHeader executes the header template text with data, and returns the lines of the result.
+func renderHeader(text string, data headerData) ([]string, error) {
+	t, err := template.New("header").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header template: %w", err)
+	}
+
+	var sb strings.Builder
+	err = t.Execute(&sb, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header template: %w", err)
+	}
+
+	return strings.Split(strings.TrimRight(sb.String(), "\n"), "\n"), nil
+}
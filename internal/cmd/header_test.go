@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewHeaderData(t *testing.T) {
+	cfg := &config{
+		options: map[string]string{"layout": "switch", "header": "x"},
+		types:   map[string]map[string]string{"Color": {"receiver": "c"}},
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		cfg      *config
+		typeName string
+		want     string
+	}{
+		{"no flags", nil, nil, "Color", ""},
+		{
+			"sorted",
+			[]string{"--skip", "Text", "--layout", "switch", "--receiver", "x"},
+			nil,
+			"Color",
+			"--layout=switch --receiver=x --skip=Text",
+		},
+		{
+			"paths and check left out",
+			[]string{"--input", "a.go", "--output", "b.go", "--pkg", "p", "--type", "Color", "--config", "c.yaml", "--header", "h", "--check"},
+			nil,
+			"Color",
+			"",
+		},
+		{"bool", []string{"--tests", "--fuzz", "--flags=false"}, nil, "Color", "--flags=false --fuzz --tests"},
+		{"quoted", []string{"--trim-prefix", "", "--receiver", `a"b`}, nil, "Color", `--receiver="a\"b" --trim-prefix=""`},
+		{"options for the type", nil, cfg, "Color", "--layout=switch --receiver=c"},
+		{"options for several types", nil, cfg, "", "--layout=switch"},
+		{"flag takes precedence", []string{"--layout", "table"}, cfg, "Color", "--layout=table --receiver=c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags()
+			if err := rootCmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			got := newHeaderData(rootCmd.Flags(), tt.cfg, tt.typeName)
+			if got.Flags != tt.want {
+				t.Errorf("Flags = %q, want %q", got.Flags, tt.want)
+			}
+			if want := strings.TrimSpace(toolName + " " + tt.want); got.Command != want {
+				t.Errorf("Command = %q, want %q", got.Command, want)
+			}
+		})
+	}
+}
+
+func TestRenderHeader(t *testing.T) {
+	data := headerData{Tool: toolName, Version: "v1.2.3", Flags: "--tests", Command: toolName + " --tests"}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    []string
+		wantErr string
+	}{
+		{"default", defaultHeader, []string{`Code generated by "go-enumerator --tests" v1.2.3; DO NOT EDIT.`}, ""},
+		{
+			"several lines",
+			"Copyright 2022\n\nCode generated by {{.Tool}} {{.Version}}. DO NOT EDIT.\n",
+			[]string{"Copyright 2022", "", "Code generated by go-enumerator v1.2.3. DO NOT EDIT."},
+			"",
+		},
+		{"parse error", "{{.Tool", nil, "invalid header template"},
+		{"execute error", "{{.Missing}}", nil, "invalid header template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderHeader(tt.text, data)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("renderHeader() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("renderHeader() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("renderHeader() error = %v, want an error containing %q", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("renderHeader() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRootCmd_Header(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		file string
+		want string
+	}{
+		{
+			"one type per file",
+			nil,
+			"color_enum.go",
+			`// Code generated by "go-enumerator --layout=switch --receiver=x --skip=Text" (devel); DO NOT EDIT.`,
+		},
+		{
+			"several types per file",
+			[]string{"--output", "multi_enum.go"},
+			"multi_enum.go",
+			`// Code generated by "go-enumerator --layout=switch --skip=Text" (devel); DO NOT EDIT.`,
+		},
+		{
+			"template",
+			[]string{"--header", "Code generated by {{.Tool}}. DO NOT EDIT.\nFlags: {{.Flags}}"},
+			"color_enum.go",
+			"// Code generated by go-enumerator. DO NOT EDIT.\n// Flags: --layout=switch --receiver=x --skip=Text\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{
+				"config.yaml": "layout: switch\ntypes:\n  Color:\n    receiver: x\n",
+			})
+
+			args := []string{
+				"--input", filepath.Join("testdata", "multi", "multi.go"),
+				"--pkg", "multi",
+				"--all",
+				"--config", filepath.Join(dir, "config.yaml"),
+				"--skip", "Text",
+				"--output", filepath.Join(dir, "{{.Unexported}}_enum.go"),
+			}
+			for i, arg := range tt.args {
+				if i > 0 && tt.args[i-1] == "--output" {
+					arg = filepath.Join(dir, arg)
+				}
+				args = append(args, arg)
+			}
+			if err := execute(args...); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			b, err := os.ReadFile(filepath.Join(dir, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(string(b), tt.want) {
+				t.Errorf("%s starts with %q, want %q", tt.file, b[:bytes.IndexByte(b, '\n')+1], tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionCmd(t *testing.T) {
+	out, err := executeOutput("version")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Test binaries are not built from a released version.
+	if want := "go-enumerator (devel)\n"; out != want {
+		t.Errorf("output = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// resetFlags resets the flags of the root command that were set by previous runs.
+func resetFlags() {
+	rootCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+// execute runs the root command with args, after resetting the flags
+// that were set by previous runs.
+func execute(args ...string) error {
+	_, err := executeOutput(args...)
+	return err
+}
+
+// executeOutput is like execute, but also returns what the command wrote to its output.
+func executeOutput(args ...string) (string, error) {
+	resetFlags()
+	var out bytes.Buffer
+	rootCmd.SetArgs(args)
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(io.Discard)
+	err := rootCmd.Execute()
+	return out.String(), err
+}
+
+func TestRootCmd_MultipleTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{
+			"all",
+			[]string{"--all"},
+			[]string{"color_enum.go", "shape_enum.go", "size_enum.go"},
+			"",
+		},
+		{
+			"type list",
+			[]string{"--type", "Color, Shape"},
+			[]string{"color_enum.go", "shape_enum.go"},
+			"",
+		},
+		{
+			"shared output file",
+			[]string{"--all", "--output", "multi_enum.go"},
+			[]string{"multi_enum.go"},
+			"",
+		},
+		{
+			"unknown type in list",
+			[]string{"--type", "Color,Missing"},
+			nil,
+			"Missing",
+		},
+		{
+			"type without constants in list",
+			[]string{"--type", "Color,Point"},
+			nil,
+			"Point",
+		},
+		{
+			"all and type",
+			[]string{"--all", "--type", "Color"},
+			nil,
+			"--all and --type cannot be used together",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			args := []string{
+				"--input", filepath.Join("testdata", "multi", "multi.go"),
+				"--pkg", "multi",
+				"--output", filepath.Join(dir, "{{.Unexported}}_enum.go"),
+			}
+			for i, arg := range tt.args {
+				if i > 0 && tt.args[i-1] == "--output" {
+					arg = filepath.Join(dir, arg)
+				}
+				args = append(args, arg)
+			}
+
+			err := execute(args...)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Execute() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("Execute() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("Execute() error = %v, want an error containing %q", err, tt.wantErr)
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, e := range entries {
+				got = append(got, e.Name())
+
+				// The function that finds suggestions for parse errors is shared by every type in a file.
+				b, err := os.ReadFile(filepath.Join(dir, e.Name()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n := strings.Count(string(b), "ClosestNames(input string"); n != 1 {
+					t.Errorf("%s declares %d ClosestNames functions, want 1", e.Name(), n)
+				}
+			}
+			sort.Strings(got)
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("files = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRootCmd_ImportedType(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			"name already declared",
+			nil,
+			"imported.go:16:6: UnitValues is already declared, so Values cannot be generated for type github.com/ajjensen13/go-enumerator/example/units.Unit",
+		},
+		{
+			"skipped",
+			[]string{"--skip", "Values"},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			args := append([]string{
+				"--input", filepath.Join("testdata", "imported", "imported.go"),
+				"--pkg", "imported",
+				"--type", "github.com/ajjensen13/go-enumerator/example/units.Unit",
+				"--output", filepath.Join(dir, "units_enum.go"),
+			}, tt.args...)
+
+			err := execute(args...)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Execute() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("Execute() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("Execute() error = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRootCmd_ExistingDecls(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     string
+		want    string
+		wantErr string
+	}{
+		{
+			"same signature",
+			"Suit",
+			// Bytes() follows the declared String().
+			"return []byte(s.String())",
+			"",
+		},
+		{
+			"different signature",
+			"Level",
+			"",
+			`existing.go:30:6: ParseLevel conflicts with the Parse generated for type "Level": it must be a func(string) (Level, error)`,
+		},
+		{
+			"only a related declaration",
+			"Mode",
+			"",
+			`existing.go:42:5: ErrInvalidMode is already declared, so Parse cannot be generated for type "Mode"`,
+		},
+		{
+			"method with different signature",
+			"Shape",
+			"",
+			`existing.go:52:16: Bytes conflicts with the Bytes generated for type "Shape": it must be a func() []byte`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := execute(
+				"--input", filepath.Join("testdata", "existing", "existing.go"),
+				"--pkg", "existing",
+				"--type", tt.typ,
+				"--output", filepath.Join(dir, "existing_enum.go"),
+			)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Execute() error = %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("Execute() error = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("Execute() error = %v, want an error containing %q", err, tt.wantErr)
+			case tt.wantErr != "":
+				return
+			}
+
+			b, err := os.ReadFile(filepath.Join(dir, "existing_enum.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(b), tt.want) {
+				t.Errorf("generated code does not contain %q", tt.want)
+			}
+		})
+	}
+}
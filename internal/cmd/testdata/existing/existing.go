@@ -0,0 +1,54 @@
+// Package existing declares enum types that already have some of the generated
+// methods and functions, for testing how they are detected.
+package existing
+
+import (
+	"errors"
+	"strings"
+)
+
+// Suit declares String() with the generated signature, so it is not generated.
+type Suit int
+
+const (
+	Spades Suit = iota
+	Hearts
+)
+
+func (s Suit) String() string {
+	return [...]string{"♠", "♥"}[s]
+}
+
+// Level declares ParseLevel() with a different result type.
+type Level int
+
+const (
+	Low Level = iota
+	High
+)
+
+func ParseLevel(s string) (int, error) {
+	return strings.Index("LH", s), nil
+}
+
+// Mode only declares the error returned by ParseMode().
+type Mode int
+
+const (
+	Read Mode = iota
+	Write
+)
+
+var ErrInvalidMode = errors.New("invalid mode")
+
+// Shape declares Bytes() with a different result type.
+type Shape int
+
+const (
+	Circle Shape = iota
+	Square
+)
+
+func (s Shape) Bytes() string {
+	return "shape"
+}
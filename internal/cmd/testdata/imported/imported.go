@@ -0,0 +1,21 @@
+// Package imported declares an enum with the same name as an imported one,
+// for testing the functions generated for imported types.
+package imported
+
+import "github.com/ajjensen13/go-enumerator/example/units"
+
+// Unit is a local enum named like units.Unit.
+type Unit int
+
+const (
+	Pixel Unit = iota
+	Point
+)
+
+// UnitValues has the name of the function generated for the values of either Unit.
+func UnitValues() []Unit {
+	return []Unit{Pixel, Point}
+}
+
+// Default is the default length unit.
+const Default = units.Meter
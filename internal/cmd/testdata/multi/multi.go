@@ -0,0 +1,29 @@
+// Package multi declares several enum types for testing --all and --type.
+package multi
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+type Size string
+
+const (
+	Small Size = "S"
+	Large Size = "L"
+)
+
+type Shape uint8
+
+const (
+	Circle Shape = iota + 1
+	Square
+)
+
+// Point has no constants, so it is not an enum.
+type Point struct {
+	X, Y int
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+)
+
+// toolName is the name of the tool, as it appears in generated files.
+const toolName = "go-enumerator"
+
+// develVersion is the version reported when the tool was not built from a released version.
+const develVersion = "(devel)"
+
+// versionCmd prints the version of the tool.
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version of go-enumerator",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Fprintln(cmd.OutOrStdout(), toolName, toolVersion())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(versionCmd)
+}
+
+// toolVersion returns the version of the tool from its build info.
+// Pseudo-versions and versions with local modifications are reported
+// as develVersion, so that building from a checkout does not change
+// the header of every generated file.
+func toolVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return develVersion
+	}
+
+	v := info.Main.Version
+	if v == "" || module.IsPseudoVersion(v) || strings.HasSuffix(v, "+dirty") {
+		return develVersion
+	}
+	return v
+}
@@ -0,0 +1,37 @@
+// Package ident changes the case of Go identifiers. It is shared by the
+// generator and the command, which both derive names from type names.
+package ident
+
+import (
+	"go/ast"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Unexported returns s with the first character replaced
+// with its lower case version if it is upper case.
+func Unexported(s string) string {
+	if !ast.IsExported(s) {
+		return s
+	}
+
+	start, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		panic("s is empty")
+	}
+
+	start = unicode.ToLower(start)
+	return string(start) + s[size:]
+}
+
+// Exported returns s with the first character replaced
+// with its upper case version if it is lower case.
+func Exported(s string) string {
+	start, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		panic("s is empty")
+	}
+
+	start = unicode.ToUpper(start)
+	return string(start) + s[size:]
+}